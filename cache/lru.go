@@ -0,0 +1,300 @@
+package cache
+
+import (
+	"sync"
+	"time"
+
+	"github.com/nodasoft/go-utils/maps"
+)
+
+// EvictReason describes why an entry was removed from the cache.
+type EvictReason int
+
+const (
+	// EvictReasonCapacity - the entry was the least recently used one when the capacity was exceeded.
+	EvictReasonCapacity EvictReason = iota
+	// EvictReasonExpired - the TTL of the entry passed.
+	EvictReasonExpired
+	// EvictReasonDeleted - the entry was deleted by Delete or Purge.
+	EvictReasonDeleted
+)
+
+// String returns the name of the reason.
+func (r EvictReason) String() string {
+	switch r {
+	case EvictReasonCapacity:
+		return "capacity"
+	case EvictReasonExpired:
+		return "expired"
+	case EvictReasonDeleted:
+		return "deleted"
+	}
+
+	return "unknown"
+}
+
+// Options configures the LRU cache. The zero value is a valid configuration.
+type Options[K comparable, V any] struct {
+	// TTL is the default time to live of entries. Zero means entries don't expire.
+	TTL time.Duration
+	// OnEvict is called after an entry is removed from the cache.
+	// It is called without holding the cache lock, so it may use the cache.
+	OnEvict func(k K, v V, reason EvictReason)
+	// Clock returns the current time. time.Now is used if nil.
+	Clock func() time.Time
+}
+
+// Stats contains cache counters.
+type Stats struct {
+	Hits      uint64
+	Misses    uint64
+	Evictions uint64 // entries removed because of capacity or TTL
+}
+
+type lruEntry[V any] struct {
+	value     V
+	expiresAt time.Time // zero means the entry doesn't expire
+}
+
+type evicted[K comparable, V any] struct {
+	key    K
+	value  V
+	reason EvictReason
+}
+
+// LRU is a cache with a capacity limit that evicts the least recently used entries.
+// Entries may have a time to live, expired entries are removed lazily when they are accessed or evicted.
+// LRU is safe for concurrent use by multiple goroutines.
+// To use this object, it is absolutely necessary to create the object using the NewLRU constructor.
+type LRU[K comparable, V any] struct {
+	mu       sync.Mutex
+	items    *maps.OrderedMap[K, lruEntry[V]] // from the least to the most recently used
+	capacity int
+	ttl      time.Duration
+	onEvict  func(K, V, EvictReason)
+	now      func() time.Time
+	stats    Stats
+}
+
+// NewLRU allocates and initializes new object of type LRU and returns a pointer to it.
+// Capacity <= 0 means the number of entries is not limited.
+// It is absolutely necessary to create the LRU object using this constructor.
+func NewLRU[K comparable, V any](capacity int, opts Options[K, V]) *LRU[K, V] {
+	c := LRU[K, V]{
+		items:    maps.NewOrderedMap[K, lruEntry[V]](max(capacity, 0)),
+		capacity: capacity,
+		ttl:      opts.TTL,
+		onEvict:  opts.OnEvict,
+		now:      opts.Clock,
+	}
+	if c.now == nil {
+		c.now = time.Now
+	}
+
+	return &c
+}
+
+// Get returns a value by the given key and marks the entry as recently used.
+// Returns false if the key is not found or the entry is expired.
+func (c *LRU[K, V]) Get(k K) (V, bool) {
+	if c == nil {
+		var v V
+		return v, false
+	}
+
+	c.mu.Lock()
+	v, ok, ev := c.get(k, true)
+	c.mu.Unlock()
+
+	c.notify(ev)
+
+	return v, ok
+}
+
+// Peek returns a value by the given key without marking the entry as recently used and without updating counters.
+// Returns false if the key is not found or the entry is expired.
+func (c *LRU[K, V]) Peek(k K) (V, bool) {
+	if c == nil {
+		var v V
+		return v, false
+	}
+
+	c.mu.Lock()
+	v, ok, ev := c.get(k, false)
+	c.mu.Unlock()
+
+	c.notify(ev)
+
+	return v, ok
+}
+
+// Has checks if the cache contains a non-expired entry by the given key.
+// Doesn't mark the entry as recently used.
+func (c *LRU[K, _]) Has(k K) bool {
+	_, ok := c.Peek(k)
+
+	return ok
+}
+
+// Set sets the given key-value pair into the cache with the default TTL and marks it as recently used.
+// If the capacity is exceeded, the least recently used entry is evicted.
+// Panics if c is not initialized by NewLRU constructor.
+func (c *LRU[K, V]) Set(k K, v V) {
+	c.SetWithTTL(k, v, c.ttl)
+}
+
+// SetWithTTL like Set, sets the given key-value pair into the cache with the given TTL.
+// Zero or negative TTL means the entry doesn't expire.
+// Panics if c is not initialized by NewLRU constructor.
+func (c *LRU[K, V]) SetWithTTL(k K, v V, ttl time.Duration) {
+	e := lruEntry[V]{value: v}
+	if ttl > 0 {
+		e.expiresAt = c.now().Add(ttl)
+	}
+
+	var ev []evicted[K, V]
+
+	c.mu.Lock()
+	c.items.Set(k, e)
+	c.items.MoveToBack(k)
+	for c.capacity > 0 && c.items.Len() > c.capacity {
+		oldest, old, _ := c.items.Front()
+		c.items.Delete(oldest)
+		c.stats.Evictions++
+		ev = append(ev, evicted[K, V]{key: oldest, value: old.value, reason: EvictReasonCapacity})
+	}
+	c.mu.Unlock()
+
+	c.notify(ev)
+}
+
+// Delete deletes an entry by the given key. Returns false if the key is not found.
+func (c *LRU[K, V]) Delete(k K) bool {
+	if c == nil {
+		return false
+	}
+
+	c.mu.Lock()
+	e, ok := c.items.GetAndCheck(k)
+	c.items.Delete(k)
+	c.mu.Unlock()
+
+	if ok {
+		c.notify([]evicted[K, V]{{key: k, value: e.value, reason: EvictReasonDeleted}})
+	}
+
+	return ok
+}
+
+// Purge deletes all entries from the cache. Counters are kept.
+func (c *LRU[K, V]) Purge() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	var ev []evicted[K, V]
+	if c.onEvict != nil {
+		ev = make([]evicted[K, V], 0, c.items.Len())
+		for k, e := range c.items.Iterate() {
+			ev = append(ev, evicted[K, V]{key: k, value: e.value, reason: EvictReasonDeleted})
+		}
+	}
+	c.items = maps.NewOrderedMap[K, lruEntry[V]](max(c.capacity, 0))
+	c.mu.Unlock()
+
+	c.notify(ev)
+}
+
+// RemoveExpired deletes all expired entries from the cache and returns their number.
+// Takes linear time.
+func (c *LRU[K, V]) RemoveExpired() int {
+	if c == nil {
+		return 0
+	}
+
+	var ev []evicted[K, V]
+
+	c.mu.Lock()
+	now := c.now()
+	for k, e := range c.items.Iterate() {
+		if e.expired(now) {
+			c.items.Delete(k)
+			c.stats.Evictions++
+			ev = append(ev, evicted[K, V]{key: k, value: e.value, reason: EvictReasonExpired})
+		}
+	}
+	c.mu.Unlock()
+
+	c.notify(ev)
+
+	return len(ev)
+}
+
+// Len returns the number of entries in the cache, including expired ones that are not removed yet.
+func (c *LRU[_, _]) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.items.Len()
+}
+
+// Stats returns a copy of the cache counters.
+func (c *LRU[_, _]) Stats() Stats {
+	if c == nil {
+		return Stats{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.stats
+}
+
+// get returns a value by the given key, removing the entry if it is expired.
+// Must be called under the lock.
+func (c *LRU[K, V]) get(k K, touch bool) (V, bool, []evicted[K, V]) {
+	var v V
+
+	e, ok := c.items.GetAndCheck(k)
+	if ok && e.expired(c.now()) {
+		c.items.Delete(k)
+		c.stats.Evictions++
+		if touch {
+			c.stats.Misses++
+		}
+
+		return v, false, []evicted[K, V]{{key: k, value: e.value, reason: EvictReasonExpired}}
+	}
+
+	if touch {
+		if !ok {
+			c.stats.Misses++
+			return v, false, nil
+		}
+		c.stats.Hits++
+		c.items.MoveToBack(k)
+	}
+
+	return e.value, ok, nil
+}
+
+// notify calls the eviction callback for every evicted entry.
+// Must be called without the lock.
+func (c *LRU[K, V]) notify(ev []evicted[K, V]) {
+	if c.onEvict == nil {
+		return
+	}
+
+	for _, e := range ev {
+		c.onEvict(e.key, e.value, e.reason)
+	}
+}
+
+func (e lruEntry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
@@ -0,0 +1,251 @@
+package cache
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+type evictedEntry struct {
+	key    string
+	value  int
+	reason EvictReason
+}
+
+func TestLRU_NotInitialized(t *testing.T) {
+	// Cache is nil
+	var c *LRU[string, int]
+	v, ok := c.Get("a")
+	assert.True(t, v == 0 && !ok)
+	v, ok = c.Peek("a")
+	assert.True(t, v == 0 && !ok)
+	assert.False(t, c.Has("a"))
+	assert.False(t, c.Delete("a"))
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 0, c.RemoveExpired())
+	assert.Equal(t, Stats{}, c.Stats())
+	assert.NotPanics(t, c.Purge)
+	assert.Panics(t, func() { c.Set("a", 1) })
+
+	// Cache is initialized without constructor
+	c = &LRU[string, int]{}
+	_, ok = c.Get("a")
+	assert.False(t, ok)
+	assert.Panics(t, func() { c.Set("a", 1) })
+}
+
+func TestLRU_Capacity(t *testing.T) {
+	var ev []evictedEntry
+	c := NewLRU[string, int](2, Options[string, int]{
+		OnEvict: func(k string, v int, reason EvictReason) {
+			ev = append(ev, evictedEntry{k, v, reason})
+		},
+	})
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+
+	// Get marks "a" as recently used, so "b" is evicted
+	v, ok := c.Get("a")
+	assert.True(t, v == 1 && ok)
+	c.Set("c", 3)
+	assert.Equal(t, []evictedEntry{{"b", 2, EvictReasonCapacity}}, ev)
+	assert.False(t, c.Has("b"))
+	assert.Equal(t, 2, c.Len())
+
+	// Peek doesn't mark "a" as recently used
+	v, ok = c.Peek("a")
+	assert.True(t, v == 1 && ok)
+	c.Set("d", 4)
+	assert.Equal(t, evictedEntry{"a", 1, EvictReasonCapacity}, ev[1])
+
+	// Updating an existing key marks it as recently used and doesn't evict
+	c.Set("c", 30)
+	assert.Len(t, ev, 2)
+	c.Set("e", 5)
+	assert.Equal(t, evictedEntry{"d", 4, EvictReasonCapacity}, ev[2])
+	v, _ = c.Get("c")
+	assert.Equal(t, 30, v)
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 0, Evictions: 3}, c.Stats())
+}
+
+func TestLRU_Unlimited(t *testing.T) {
+	c := NewLRU[int, int](0, Options[int, int]{})
+	for i := 0; i < 1000; i++ {
+		c.Set(i, i)
+	}
+	assert.Equal(t, 1000, c.Len())
+	assert.Equal(t, uint64(0), c.Stats().Evictions)
+}
+
+func TestLRU_TTL(t *testing.T) {
+	clock := &fakeClock{now: time.Date(2024, 9, 20, 0, 0, 0, 0, time.UTC)}
+	var ev []evictedEntry
+	c := NewLRU[string, int](10, Options[string, int]{
+		TTL:   time.Minute,
+		Clock: clock.Now,
+		OnEvict: func(k string, v int, reason EvictReason) {
+			ev = append(ev, evictedEntry{k, v, reason})
+		},
+	})
+
+	c.Set("default", 1)
+	c.SetWithTTL("long", 2, time.Hour)
+	c.SetWithTTL("forever", 3, 0)
+
+	clock.Advance(time.Minute - time.Nanosecond)
+	assert.True(t, c.Has("default"))
+
+	// Expired entry is removed on access
+	clock.Advance(time.Nanosecond)
+	_, ok := c.Get("default")
+	assert.False(t, ok)
+	assert.Equal(t, []evictedEntry{{"default", 1, EvictReasonExpired}}, ev)
+	assert.Equal(t, 2, c.Len())
+
+	v, ok := c.Get("long")
+	assert.True(t, v == 2 && ok)
+
+	// Access doesn't prolong TTL
+	clock.Advance(time.Hour)
+	assert.Equal(t, 2, c.Len())
+	assert.Equal(t, 1, c.RemoveExpired())
+	assert.Equal(t, evictedEntry{"long", 2, EvictReasonExpired}, ev[1])
+
+	v, ok = c.Get("forever")
+	assert.True(t, v == 3 && ok)
+
+	assert.Equal(t, Stats{Hits: 2, Misses: 1, Evictions: 2}, c.Stats())
+}
+
+func TestLRU_DeleteAndPurge(t *testing.T) {
+	var ev []evictedEntry
+	c := NewLRU[string, int](10, Options[string, int]{
+		OnEvict: func(k string, v int, reason EvictReason) {
+			ev = append(ev, evictedEntry{k, v, reason})
+		},
+	})
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	assert.True(t, c.Delete("b"))
+	assert.False(t, c.Delete("b"))
+	assert.Equal(t, []evictedEntry{{"b", 2, EvictReasonDeleted}}, ev)
+
+	c.Purge()
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, []evictedEntry{
+		{"b", 2, EvictReasonDeleted},
+		{"a", 1, EvictReasonDeleted},
+		{"c", 3, EvictReasonDeleted},
+	}, ev)
+
+	// Deletions are not counted as evictions
+	assert.Equal(t, uint64(0), c.Stats().Evictions)
+
+	// Cache is usable after purge
+	c.Set("d", 4)
+	assert.True(t, c.Has("d"))
+}
+
+func TestLRU_OnEvictMayUseCache(t *testing.T) {
+	var c *LRU[string, int]
+	c = NewLRU[string, int](2, Options[string, int]{
+		OnEvict: func(k string, v int, reason EvictReason) {
+			if k != "last" {
+				c.Set("last", v)
+			}
+		},
+	})
+
+	c.Set("a", 1)
+	c.Set("b", 2)
+	c.Set("c", 3)
+
+	// "a" is evicted and the callback sets "last", which evicts "b", which updates "last"
+	v, ok := c.Get("last")
+	assert.True(t, v == 2 && ok)
+	assert.True(t, c.Has("c"))
+}
+
+func TestEvictReason_String(t *testing.T) {
+	assert.Equal(t, "capacity", EvictReasonCapacity.String())
+	assert.Equal(t, "expired", EvictReasonExpired.String())
+	assert.Equal(t, "deleted", EvictReasonDeleted.String())
+	assert.Equal(t, "unknown", EvictReason(100).String())
+}
+
+func TestLRU_Concurrent(t *testing.T) {
+	const (
+		workers  = 8
+		capacity = 50
+	)
+
+	clock := &fakeClock{now: time.Now()}
+	c := NewLRU[string, int](capacity, Options[string, int]{TTL: time.Second, Clock: clock.Now})
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 200; i++ {
+				k := fmt.Sprint(i % (capacity * 2))
+				c.Set(k, w)
+				c.Get(k)
+				c.Peek(k)
+				if i%50 == 0 {
+					clock.Advance(time.Second)
+					c.RemoveExpired()
+				}
+				if i%70 == 0 {
+					c.Delete(k)
+				}
+				c.Stats()
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	assert.LessOrEqual(t, c.Len(), capacity)
+}
+
+func BenchmarkLRU(b *testing.B) {
+	size := 1000
+	c := NewLRU[int, int](size, Options[int, int]{})
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if _, ok := c.Get(i % (size * 2)); !ok {
+				c.Set(i%(size*2), i)
+			}
+			i++
+		}
+	})
+}
@@ -1,6 +1,6 @@
 package generics
 
-// Numeric - comparable numeric types.
+// Numeric - comparable numeric types, including named types based on them like time.Duration.
 type Numeric interface {
-	int | int8 | int16 | int32 | int64 | float32 | float64 | uint | uint8 | uint16 | uint32 | uint64
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~float32 | ~float64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64
 }
@@ -0,0 +1,146 @@
+package heap
+
+import (
+	"cmp"
+)
+
+// Heap is a binary heap, which can be used as a priority queue.
+// The element at the top is the minimum according to the comparator of the heap;
+// a reversed comparator makes a max-heap. Push and Pop take O(log n) time, Peek takes O(1) time.
+// To use this object, it is absolutely necessary to create the object using the New or NewOrdered constructors.
+// Heap is not safe for concurrent use.
+type Heap[T any] struct {
+	data []T
+	cmp  func(a, b T) int
+}
+
+// New allocates and initializes new object of type Heap with the given comparator and returns a pointer to it.
+// cmp returns a negative number if a < b, a positive number if a > b and 0 otherwise.
+// The given items are copied into the heap in O(n) time.
+// It is absolutely necessary to create the Heap object using this constructor or NewOrdered.
+func New[T any](cmp func(a, b T) int, items ...T) *Heap[T] {
+	h := Heap[T]{
+		data: append([]T(nil), items...),
+		cmp:  cmp,
+	}
+	for i := len(h.data)/2 - 1; i >= 0; i-- {
+		h.down(i)
+	}
+
+	return &h
+}
+
+// NewOrdered allocates and initializes new object of type Heap with the natural order of T,
+// so the minimum element is at the top, and returns a pointer to it.
+func NewOrdered[T cmp.Ordered](items ...T) *Heap[T] {
+	return New(cmp.Compare[T], items...)
+}
+
+// Len returns the number of elements in the heap.
+func (h *Heap[_]) Len() int {
+	if h == nil {
+		return 0
+	}
+
+	return len(h.data)
+}
+
+// Push adds the element to the heap.
+// Panics if h is not initialized by New or NewOrdered constructors.
+func (h *Heap[T]) Push(v T) {
+	h.data = append(h.data, v)
+	h.up(len(h.data) - 1)
+}
+
+// Peek returns the top element without removing it. If the heap is empty, returns the zero value and false.
+func (h *Heap[T]) Peek() (T, bool) {
+	if h.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	return h.data[0], true
+}
+
+// Pop removes and returns the top element. If the heap is empty, returns the zero value and false.
+func (h *Heap[T]) Pop() (T, bool) {
+	return h.Remove(0)
+}
+
+// Remove removes and returns the element at the index i of the heap storage, see IndexFunc.
+// If i is out of range, returns the zero value and false.
+func (h *Heap[T]) Remove(i int) (T, bool) {
+	if i < 0 || i >= h.Len() {
+		var zero T
+		return zero, false
+	}
+
+	v := h.data[i]
+	last := len(h.data) - 1
+	h.data[i] = h.data[last]
+	var zero T
+	h.data[last] = zero // don't keep a reference to the removed element
+	h.data = h.data[:last]
+	if i < last {
+		h.Fix(i)
+	}
+
+	return v, true
+}
+
+// Fix restores the order of the heap after the element at the index i of the heap storage has changed,
+// e.g. the priority of a pointer element is updated. It is cheaper than removing and pushing the element again.
+func (h *Heap[T]) Fix(i int) {
+	if i < 0 || i >= h.Len() {
+		return
+	}
+
+	if !h.down(i) {
+		h.up(i)
+	}
+}
+
+// IndexFunc returns the index in the heap storage of the first element satisfying pred, -1 if there is no such element.
+// The index is valid until the heap is modified. It takes O(n) time.
+func (h *Heap[T]) IndexFunc(pred func(T) bool) int {
+	for i := 0; i < h.Len(); i++ {
+		if pred(h.data[i]) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// up moves the element at the index i up to its place.
+func (h *Heap[T]) up(i int) {
+	for i > 0 {
+		parent := (i - 1) / 2
+		if h.cmp(h.data[i], h.data[parent]) >= 0 {
+			return
+		}
+		h.data[i], h.data[parent] = h.data[parent], h.data[i]
+		i = parent
+	}
+}
+
+// down moves the element at the index i down to its place and reports whether it is moved.
+func (h *Heap[T]) down(i int) bool {
+	start := i
+	for {
+		least := 2*i + 1
+		if least >= len(h.data) {
+			break
+		}
+		if right := least + 1; right < len(h.data) && h.cmp(h.data[right], h.data[least]) < 0 {
+			least = right
+		}
+		if h.cmp(h.data[least], h.data[i]) >= 0 {
+			break
+		}
+		h.data[i], h.data[least] = h.data[least], h.data[i]
+		i = least
+	}
+
+	return i > start
+}
@@ -0,0 +1,135 @@
+package heap
+
+import (
+	"cmp"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func popAll[T any](h *Heap[T]) []T {
+	var res []T
+	for h.Len() > 0 {
+		v, _ := h.Pop()
+		res = append(res, v)
+	}
+
+	return res
+}
+
+func TestHeap_NotInitialized(t *testing.T) {
+	var h *Heap[int]
+	assert.Equal(t, 0, h.Len())
+	_, ok := h.Peek()
+	assert.False(t, ok)
+	_, ok = h.Pop()
+	assert.False(t, ok)
+	assert.Equal(t, -1, h.IndexFunc(func(int) bool { return true }))
+	assert.NotPanics(t, func() { h.Fix(0) })
+	assert.Panics(t, func() { h.Push(1) })
+}
+
+func TestHeap(t *testing.T) {
+	h := NewOrdered(5, 3, 8)
+	h.Push(1)
+	h.Push(9)
+	h.Push(3)
+
+	assert.Equal(t, 6, h.Len())
+	v, ok := h.Peek()
+	assert.True(t, v == 1 && ok)
+	assert.Equal(t, 6, h.Len())
+
+	assert.Equal(t, []int{1, 3, 3, 5, 8, 9}, popAll(h))
+	_, ok = h.Pop()
+	assert.False(t, ok)
+
+	// Max-heap with a reversed comparator
+	maxHeap := New(func(a, b string) int { return cmp.Compare(b, a) }, "b", "c", "a")
+	assert.Equal(t, []string{"c", "b", "a"}, popAll(maxHeap))
+
+	// Items are copied
+	items := []int{3, 2, 1}
+	h = NewOrdered(items...)
+	h.Pop()
+	assert.Equal(t, []int{3, 2, 1}, items)
+}
+
+func TestHeap_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	h := NewOrdered[int]()
+
+	var expected []int
+	for i := 0; i < 1000; i++ {
+		v := rnd.Intn(100)
+		h.Push(v)
+		expected = append(expected, v)
+	}
+	sort.Ints(expected)
+
+	assert.Equal(t, expected, popAll(h))
+}
+
+func TestHeap_Fix(t *testing.T) {
+	type task struct {
+		name     string
+		priority int
+	}
+	tasks := []*task{{"a", 3}, {"b", 1}, {"c", 2}, {"d", 5}}
+	h := New(func(a, b *task) int { return cmp.Compare(a.priority, b.priority) }, tasks...)
+
+	// Increase the priority
+	i := h.IndexFunc(func(t *task) bool { return t.name == "d" })
+	require.GreaterOrEqual(t, i, 0)
+	tasks[3].priority = 0
+	h.Fix(i)
+	top, _ := h.Peek()
+	assert.Equal(t, "d", top.name)
+
+	// Decrease the priority
+	i = h.IndexFunc(func(t *task) bool { return t.name == "d" })
+	tasks[3].priority = 10
+	h.Fix(i)
+
+	var names []string
+	for _, t := range popAll(h) {
+		names = append(names, t.name)
+	}
+	assert.Equal(t, []string{"b", "c", "a", "d"}, names)
+	assert.Equal(t, -1, h.IndexFunc(func(t *task) bool { return true }))
+}
+
+func TestHeap_Remove(t *testing.T) {
+	h := NewOrdered(1, 2, 3, 4, 5, 6, 7)
+
+	i := h.IndexFunc(func(v int) bool { return v == 4 })
+	v, ok := h.Remove(i)
+	assert.True(t, v == 4 && ok)
+	_, ok = h.Remove(10)
+	assert.False(t, ok)
+	_, ok = h.Remove(-1)
+	assert.False(t, ok)
+
+	assert.Equal(t, []int{1, 2, 3, 5, 6, 7}, popAll(h))
+}
+
+func BenchmarkHeap(b *testing.B) {
+	rnd := rand.New(rand.NewSource(1))
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = rnd.Int()
+	}
+
+	for i := 0; i < b.N; i++ {
+		h := NewOrdered[int]()
+		for _, v := range values {
+			h.Push(v)
+		}
+		for h.Len() > 0 {
+			h.Pop()
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package iterators
+
+import (
+	"iter"
+)
+
+// FromSlice returns an iterator over the slice elements.
+func FromSlice[T any](sl []T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range sl {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// FromMap returns an iterator over the map elements. The order is not specified.
+func FromMap[K comparable, V any](m map[K]V) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range m {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Keys returns an iterator over the keys of seq.
+func Keys[K, V any](seq iter.Seq2[K, V]) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range seq {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the values of seq.
+func Values[K, V any](seq iter.Seq2[K, V]) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range seq {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Map returns an iterator over the elements of seq converted by fn.
+func Map[T, R any](seq iter.Seq[T], fn func(T) R) iter.Seq[R] {
+	return func(yield func(R) bool) {
+		for v := range seq {
+			if !yield(fn(v)) {
+				return
+			}
+		}
+	}
+}
+
+// Map2 returns an iterator over the pairs of seq converted by fn.
+func Map2[K, V, K2, V2 any](seq iter.Seq2[K, V], fn func(K, V) (K2, V2)) iter.Seq2[K2, V2] {
+	return func(yield func(K2, V2) bool) {
+		for k, v := range seq {
+			if !yield(fn(k, v)) {
+				return
+			}
+		}
+	}
+}
+
+// Filter returns an iterator over the elements of seq for which pred returns true.
+func Filter[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if pred(v) && !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Filter2 returns an iterator over the pairs of seq for which pred returns true.
+func Filter2[K, V any](seq iter.Seq2[K, V], pred func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if pred(k, v) && !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Take returns an iterator over the first n elements of seq.
+// seq is not advanced beyond the n-th element.
+func Take[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for v := range seq {
+			if !yield(v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Take2 returns an iterator over the first n pairs of seq.
+// seq is not advanced beyond the n-th pair.
+func Take2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if n <= 0 {
+			return
+		}
+		i := 0
+		for k, v := range seq {
+			if !yield(k, v) {
+				return
+			}
+			if i++; i >= n {
+				return
+			}
+		}
+	}
+}
+
+// Skip returns an iterator over the elements of seq except the first n.
+func Skip[T any](seq iter.Seq[T], n int) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		i := 0
+		for v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Skip2 returns an iterator over the pairs of seq except the first n.
+func Skip2[K, V any](seq iter.Seq2[K, V], n int) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		i := 0
+		for k, v := range seq {
+			if i < n {
+				i++
+				continue
+			}
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile returns an iterator over the leading elements of seq for which pred returns true.
+func TakeWhile[T any](seq iter.Seq[T], pred func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range seq {
+			if !pred(v) || !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// TakeWhile2 returns an iterator over the leading pairs of seq for which pred returns true.
+func TakeWhile2[K, V any](seq iter.Seq2[K, V], pred func(K, V) bool) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		for k, v := range seq {
+			if !pred(k, v) || !yield(k, v) {
+				return
+			}
+		}
+	}
+}
+
+// Chunk returns an iterator over consecutive chunks of seq with up to size elements.
+// The last chunk may be shorter. Every chunk is a new slice.
+// Panics if size is less than 1.
+func Chunk[T any](seq iter.Seq[T], size int) iter.Seq[[]T] {
+	if size < 1 {
+		panic("iterators: chunk size must be positive")
+	}
+
+	return func(yield func([]T) bool) {
+		var chunk []T
+		for v := range seq {
+			if chunk == nil {
+				chunk = make([]T, 0, size)
+			}
+			chunk = append(chunk, v)
+			if len(chunk) == size {
+				if !yield(chunk) {
+					return
+				}
+				chunk = nil
+			}
+		}
+		if len(chunk) > 0 {
+			yield(chunk)
+		}
+	}
+}
+
+// Zip returns an iterator over pairs of elements of a and b taken at the same positions.
+// The iteration stops when either of the iterators is exhausted.
+func Zip[A, B any](a iter.Seq[A], b iter.Seq[B]) iter.Seq2[A, B] {
+	return func(yield func(A, B) bool) {
+		nextB, stop := iter.Pull(b)
+		defer stop()
+
+		for va := range a {
+			vb, ok := nextB()
+			if !ok || !yield(va, vb) {
+				return
+			}
+		}
+	}
+}
+
+// Enumerate returns an iterator over the elements of seq paired with their indexes.
+func Enumerate[T any](seq iter.Seq[T]) iter.Seq2[int, T] {
+	return func(yield func(int, T) bool) {
+		i := 0
+		for v := range seq {
+			if !yield(i, v) {
+				return
+			}
+			i++
+		}
+	}
+}
+
+// Concat returns an iterator over the elements of all the given iterators one after another.
+func Concat[T any](seqs ...iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, seq := range seqs {
+			for v := range seq {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Flatten returns an iterator over the elements of all the iterators produced by seq.
+func Flatten[T any](seq iter.Seq[iter.Seq[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for inner := range seq {
+			for v := range inner {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// FlattenSlices returns an iterator over the elements of all the slices produced by seq.
+func FlattenSlices[T any](seq iter.Seq[[]T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for sl := range seq {
+			for _, v := range sl {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Distinct returns an iterator over the elements of seq without repeats, keeping the first occurrences.
+// Seen elements are kept in memory until the iteration ends.
+func Distinct[T comparable](seq iter.Seq[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		seen := make(map[T]struct{})
+		for v := range seq {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Reduce accumulates the elements of seq into a single value, starting with init.
+func Reduce[T, R any](seq iter.Seq[T], init R, fn func(acc R, v T) R) R {
+	acc := init
+	for v := range seq {
+		acc = fn(acc, v)
+	}
+
+	return acc
+}
+
+// Collect collects the elements of seq into a new slice.
+// If seq produces no elements, returns nil.
+func Collect[T any](seq iter.Seq[T]) []T {
+	var sl []T
+	for v := range seq {
+		sl = append(sl, v)
+	}
+
+	return sl
+}
+
+// CollectMap collects the pairs of seq into a new map. Later pairs overwrite earlier ones with the same key.
+func CollectMap[K comparable, V any](seq iter.Seq2[K, V]) map[K]V {
+	m := make(map[K]V)
+	for k, v := range seq {
+		m[k] = v
+	}
+
+	return m
+}
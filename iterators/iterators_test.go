@@ -0,0 +1,217 @@
+package iterators
+
+import (
+	"iter"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// countingSeq returns an iterator over 0..n-1 that counts produced elements.
+func countingSeq(n int, produced *int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for i := 0; i < n; i++ {
+			*produced++
+			if !yield(i) {
+				return
+			}
+		}
+	}
+}
+
+func TestFromSlice(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 3}, Collect(FromSlice([]int{1, 2, 3})))
+	assert.Nil(t, Collect(FromSlice[int](nil)))
+	assert.Equal(t, []int{1}, Collect(Take(FromSlice([]int{1, 2, 3}), 1)))
+}
+
+func TestFromMap(t *testing.T) {
+	m := map[string]int{"a": 1, "b": 2}
+	assert.Equal(t, m, CollectMap(FromMap(m)))
+	assert.Empty(t, CollectMap(FromMap[string, int](nil)))
+	assert.Len(t, Collect(Keys(Take2(FromMap(m), 1))), 1)
+
+	keys := Collect(Keys(FromMap(m)))
+	slices.Sort(keys)
+	assert.Equal(t, []string{"a", "b"}, keys)
+	values := Collect(Values(FromMap(m)))
+	slices.Sort(values)
+	assert.Equal(t, []int{1, 2}, values)
+}
+
+func TestMap(t *testing.T) {
+	assert.Equal(t, []string{"1", "2"}, Collect(Map(FromSlice([]int{1, 2}), strconv.Itoa)))
+
+	pairs := Map2(Enumerate(FromSlice([]string{"a", "b"})), func(i int, s string) (string, int) { return s, i })
+	assert.Equal(t, map[string]int{"a": 0, "b": 1}, CollectMap(pairs))
+}
+
+func TestFilter(t *testing.T) {
+	even := func(v int) bool { return v%2 == 0 }
+	assert.Equal(t, []int{2, 4}, Collect(Filter(FromSlice([]int{1, 2, 3, 4, 5}), even)))
+	assert.Nil(t, Collect(Filter(FromSlice([]int{1, 3}), even)))
+
+	pairs := Filter2(Enumerate(FromSlice([]string{"a", "b", "c"})), func(i int, _ string) bool { return i != 1 })
+	assert.Equal(t, []string{"a", "c"}, Collect(Values(pairs)))
+}
+
+func TestTake(t *testing.T) {
+	produced := 0
+	assert.Equal(t, []int{0, 1, 2}, Collect(Take(countingSeq(100, &produced), 3)))
+	assert.Equal(t, 3, produced)
+
+	produced = 0
+	assert.Nil(t, Collect(Take(countingSeq(100, &produced), 0)))
+	assert.Equal(t, 0, produced)
+	assert.Equal(t, []int{0, 1}, Collect(Take(countingSeq(2, &produced), 5)))
+
+	pairs := Take2(Enumerate(FromSlice([]string{"a", "b", "c"})), 2)
+	assert.Equal(t, []string{"a", "b"}, Collect(Values(pairs)))
+	assert.Nil(t, Collect(Values(Take2(Enumerate(FromSlice([]string{"a"})), -1))))
+}
+
+func TestSkip(t *testing.T) {
+	assert.Equal(t, []int{3, 4}, Collect(Skip(FromSlice([]int{1, 2, 3, 4}), 2)))
+	assert.Equal(t, []int{1, 2}, Collect(Skip(FromSlice([]int{1, 2}), -1)))
+	assert.Nil(t, Collect(Skip(FromSlice([]int{1, 2}), 5)))
+
+	// Iterator is reusable
+	seq := Skip(FromSlice([]int{1, 2, 3}), 1)
+	assert.Equal(t, Collect(seq), Collect(seq))
+
+	pairs := Skip2(Enumerate(FromSlice([]string{"a", "b", "c"})), 2)
+	assert.Equal(t, []int{2}, Collect(Keys(pairs)))
+}
+
+func TestTakeWhile(t *testing.T) {
+	produced := 0
+	small := func(v int) bool { return v < 3 }
+	assert.Equal(t, []int{0, 1, 2}, Collect(TakeWhile(countingSeq(100, &produced), small)))
+	assert.Equal(t, 4, produced)
+
+	pairs := TakeWhile2(Enumerate(FromSlice([]string{"a", "b", "", "c"})), func(_ int, s string) bool { return s != "" })
+	assert.Equal(t, []string{"a", "b"}, Collect(Values(pairs)))
+}
+
+func TestChunk(t *testing.T) {
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Collect(Chunk(FromSlice([]int{1, 2, 3, 4, 5}), 2)))
+	assert.Equal(t, [][]int{{1, 2}}, Collect(Chunk(FromSlice([]int{1, 2}), 2)))
+	assert.Nil(t, Collect(Chunk(FromSlice[int](nil), 2)))
+	assert.Panics(t, func() { Chunk(FromSlice([]int{1}), 0) })
+
+	// Chunks don't share memory
+	chunks := Collect(Chunk(FromSlice([]int{1, 2, 3, 4}), 2))
+	chunks[0] = append(chunks[0], 10)
+	assert.Equal(t, []int{3, 4}, chunks[1])
+
+	// Early stop
+	produced := 0
+	assert.Equal(t, [][]int{{0, 1, 2}}, Collect(Take(Chunk(countingSeq(100, &produced), 3), 1)))
+	assert.Equal(t, 3, produced)
+}
+
+func TestZip(t *testing.T) {
+	zipped := Zip(FromSlice([]int{1, 2, 3}), FromSlice([]string{"a", "b"}))
+	assert.Equal(t, map[int]string{1: "a", 2: "b"}, CollectMap(zipped))
+
+	produced := 0
+	zipped = Zip(countingSeq(100, &produced), FromSlice([]string{"a"}))
+	assert.Len(t, CollectMap(zipped), 1)
+	assert.Equal(t, 2, produced)
+
+	zipped = Zip(FromSlice([]int{1, 2, 3}), FromSlice([]string{"a", "b", "c"}))
+	assert.Equal(t, []int{1, 2}, Collect(Keys(Take2(zipped, 2))))
+}
+
+func TestEnumerate(t *testing.T) {
+	assert.Equal(t, map[int]string{0: "a", 1: "b"}, CollectMap(Enumerate(FromSlice([]string{"a", "b"}))))
+	assert.Equal(t, []int{0, 1}, Collect(Keys(Enumerate(FromSlice([]string{"a", "b"})))))
+}
+
+func TestConcat(t *testing.T) {
+	seq := Concat(FromSlice([]int{1, 2}), FromSlice[int](nil), FromSlice([]int{3}))
+	assert.Equal(t, []int{1, 2, 3}, Collect(seq))
+	assert.Equal(t, []int{1, 2}, Collect(Take(seq, 2)))
+	assert.Nil(t, Collect(Concat[int]()))
+}
+
+func TestFlatten(t *testing.T) {
+	seqs := FromSlice([]iter.Seq[int]{FromSlice([]int{1, 2}), FromSlice([]int{3})})
+	assert.Equal(t, []int{1, 2, 3}, Collect(Flatten(seqs)))
+	assert.Equal(t, []int{1}, Collect(Take(Flatten(seqs), 1)))
+
+	assert.Equal(t, []int{1, 2, 3}, Collect(FlattenSlices(FromSlice([][]int{{1}, nil, {2, 3}}))))
+	assert.Equal(t, []int{1, 2}, Collect(Take(FlattenSlices(FromSlice([][]int{{1}, {2, 3}})), 2)))
+}
+
+func TestDistinct(t *testing.T) {
+	assert.Equal(t, []int{3, 1, 2}, Collect(Distinct(FromSlice([]int{3, 1, 3, 2, 1}))))
+	assert.Equal(t, []int{3, 1}, Collect(Take(Distinct(FromSlice([]int{3, 3, 1, 2})), 2)))
+
+	// Iterator is reusable
+	seq := Distinct(FromSlice([]int{1, 1, 2}))
+	assert.Equal(t, Collect(seq), Collect(seq))
+}
+
+func TestReduce(t *testing.T) {
+	sum := func(acc, v int) int { return acc + v }
+	assert.Equal(t, 10, Reduce(FromSlice([]int{1, 2, 3, 4}), 0, sum))
+	assert.Equal(t, 5, Reduce(FromSlice[int](nil), 5, sum))
+
+	joined := Reduce(FromSlice([]int{1, 2}), "", func(acc string, v int) string { return acc + strconv.Itoa(v) })
+	assert.Equal(t, "12", joined)
+}
+
+func TestPipeline(t *testing.T) {
+	produced := 0
+	squares := Map(Filter(countingSeq(1_000_000, &produced), func(v int) bool { return v%2 == 1 }), func(v int) int {
+		return v * v
+	})
+	assert.Equal(t, []int{1, 9, 25}, Collect(Take(squares, 3)))
+	assert.Equal(t, 6, produced)
+}
+
+func BenchmarkPipeline(b *testing.B) {
+	const size = 10_000
+
+	sl := make([]int, size)
+	for i := range sl {
+		sl[i] = i % 1000
+	}
+
+	b.Run("Iterators", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			seq := Map(Distinct(Filter(FromSlice(sl), func(v int) bool { return v%2 == 0 })), func(v int) int { return v * 2 })
+			Reduce(seq, 0, func(acc, v int) int { return acc + v })
+		}
+	})
+
+	b.Run("Slices", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			filtered := make([]int, 0)
+			for _, v := range sl {
+				if v%2 == 0 {
+					filtered = append(filtered, v)
+				}
+			}
+			seen := make(map[int]struct{})
+			distinct := make([]int, 0)
+			for _, v := range filtered {
+				if _, ok := seen[v]; !ok {
+					seen[v] = struct{}{}
+					distinct = append(distinct, v)
+				}
+			}
+			mapped := make([]int, 0, len(distinct))
+			for _, v := range distinct {
+				mapped = append(mapped, v*2)
+			}
+			sum := 0
+			for _, v := range mapped {
+				sum += v
+			}
+		}
+	})
+}
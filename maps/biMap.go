@@ -0,0 +1,145 @@
+package maps
+
+import (
+	"fmt"
+	"iter"
+)
+
+// DuplicateValuePolicy defines what BiMap does when a value is set by a key while it is bound to another key.
+type DuplicateValuePolicy int
+
+const (
+	// RejectDuplicateValues - BiMap.Set returns ErrDuplicateValue and doesn't change the map.
+	RejectDuplicateValues DuplicateValuePolicy = iota
+	// ReplaceDuplicateValues - BiMap.Set deletes the other key bound to the value.
+	ReplaceDuplicateValues
+)
+
+// BiMap represents a bidirectional map, where both keys and values are unique,
+// so an element can be looked up by a key and by a value.
+// To use this object, it is absolutely necessary to create the object using the NewBiMap constructor.
+type BiMap[K, V comparable] struct {
+	forward map[K]V
+	inverse map[V]K
+	policy  DuplicateValuePolicy
+}
+
+// NewBiMap allocates and initializes new object of type BiMap and returns a pointer to it.
+// The policy defines what Set does when a value is bound to another key.
+// It is absolutely necessary to create the BiMap object using this constructor.
+func NewBiMap[K, V comparable](size int, policy DuplicateValuePolicy) *BiMap[K, V] {
+	m := BiMap[K, V]{
+		forward: make(map[K]V, size),
+		inverse: make(map[V]K, size),
+		policy:  policy,
+	}
+
+	return &m
+}
+
+// Len returns the length of map.
+func (m *BiMap[_, _]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	return len(m.forward)
+}
+
+// HasKey checks if the map contains the given key.
+func (m *BiMap[K, _]) HasKey(k K) bool {
+	_, ok := m.GetByKey(k)
+
+	return ok
+}
+
+// HasValue checks if the map contains the given value.
+func (m *BiMap[_, V]) HasValue(v V) bool {
+	_, ok := m.GetByValue(v)
+
+	return ok
+}
+
+// GetByKey returns a value by the given key and checks if the map contains the key.
+func (m *BiMap[K, V]) GetByKey(k K) (V, bool) {
+	if m == nil {
+		var v V
+		return v, false
+	}
+
+	v, ok := m.forward[k]
+
+	return v, ok
+}
+
+// GetByValue returns a key by the given value and checks if the map contains the value.
+func (m *BiMap[K, V]) GetByValue(v V) (K, bool) {
+	if m == nil {
+		var k K
+		return k, false
+	}
+
+	k, ok := m.inverse[v]
+
+	return k, ok
+}
+
+// Set binds the given key and value, replacing the previous value of the key.
+// If the value is bound to another key, Set returns ErrDuplicateValue or deletes the other key,
+// according to the policy of the map.
+// Panics if m is not initialized by NewBiMap constructor.
+func (m *BiMap[K, V]) Set(k K, v V) error {
+	if other, ok := m.inverse[v]; ok && other != k {
+		if m.policy != ReplaceDuplicateValues {
+			return fmt.Errorf("%w: %v is bound to %v", ErrDuplicateValue, v, other)
+		}
+		delete(m.forward, other)
+	}
+
+	if old, ok := m.forward[k]; ok {
+		delete(m.inverse, old)
+	}
+
+	m.forward[k] = v
+	m.inverse[v] = k
+
+	return nil
+}
+
+// DeleteByKey deletes an element by the given key if the key exists.
+func (m *BiMap[K, _]) DeleteByKey(k K) {
+	if m == nil {
+		return
+	}
+
+	if v, ok := m.forward[k]; ok {
+		delete(m.forward, k)
+		delete(m.inverse, v)
+	}
+}
+
+// DeleteByValue deletes an element by the given value if the value exists.
+func (m *BiMap[_, V]) DeleteByValue(v V) {
+	if m == nil {
+		return
+	}
+
+	if k, ok := m.inverse[v]; ok {
+		delete(m.inverse, v)
+		delete(m.forward, k)
+	}
+}
+
+// Iterate iterates over map elements. The order is not specified.
+func (m *BiMap[K, V]) Iterate() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+		for k, v := range m.forward {
+			if !yield(k, v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package maps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBiMap_NotInitialized(t *testing.T) {
+	// Map is nil
+	var m *BiMap[string, int]
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.HasKey("a"))
+	assert.False(t, m.HasValue(1))
+	_, ok := m.GetByKey("a")
+	assert.False(t, ok)
+	_, ok = m.GetByValue(1)
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { m.DeleteByKey("a") })
+	assert.NotPanics(t, func() { m.DeleteByValue(1) })
+	assert.Panics(t, func() { _ = m.Set("a", 1) })
+	for k, v := range m.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%s, value='%d'", k, v))
+	}
+
+	// Map is initialized without constructor
+	m = &BiMap[string, int]{}
+	assert.Equal(t, 0, m.Len())
+	assert.Panics(t, func() { _ = m.Set("a", 1) })
+}
+
+func TestBiMap_Reject(t *testing.T) {
+	m := NewBiMap[string, int](2, RejectDuplicateValues)
+	require.NoError(t, m.Set("a", 1))
+	require.NoError(t, m.Set("b", 2))
+
+	v, ok := m.GetByKey("a")
+	assert.True(t, v == 1 && ok)
+	k, ok := m.GetByValue(2)
+	assert.True(t, k == "b" && ok)
+
+	// Value bound to another key is rejected
+	err := m.Set("c", 1)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.False(t, m.HasKey("c"))
+	k, _ = m.GetByValue(1)
+	assert.Equal(t, "a", k)
+
+	// Setting the same pair again is allowed
+	require.NoError(t, m.Set("a", 1))
+
+	// Changing the value of a key releases the old value
+	require.NoError(t, m.Set("a", 3))
+	assert.False(t, m.HasValue(1))
+	k, _ = m.GetByValue(3)
+	assert.Equal(t, "a", k)
+	require.NoError(t, m.Set("c", 1))
+	assert.Equal(t, 3, m.Len())
+	assertBiMapConsistent(t, m)
+}
+
+func TestBiMap_Replace(t *testing.T) {
+	m := NewBiMap[string, int](2, ReplaceDuplicateValues)
+	require.NoError(t, m.Set("a", 1))
+	require.NoError(t, m.Set("b", 2))
+
+	// The other key bound to the value is deleted
+	require.NoError(t, m.Set("b", 1))
+	assert.False(t, m.HasKey("a"))
+	assert.False(t, m.HasValue(2))
+	k, _ := m.GetByValue(1)
+	assert.Equal(t, "b", k)
+	assert.Equal(t, 1, m.Len())
+	assertBiMapConsistent(t, m)
+}
+
+func TestBiMap_Delete(t *testing.T) {
+	m := NewBiMap[string, int](3, RejectDuplicateValues)
+	require.NoError(t, m.Set("a", 1))
+	require.NoError(t, m.Set("b", 2))
+	require.NoError(t, m.Set("c", 3))
+
+	m.DeleteByKey("a")
+	m.DeleteByKey("z")
+	assert.False(t, m.HasValue(1))
+
+	m.DeleteByValue(2)
+	m.DeleteByValue(100)
+	assert.False(t, m.HasKey("b"))
+
+	assert.Equal(t, 1, m.Len())
+	assertBiMapConsistent(t, m)
+
+	// Released values may be bound again
+	require.NoError(t, m.Set("d", 1))
+}
+
+func assertBiMapConsistent[K, V comparable](t *testing.T, m *BiMap[K, V]) {
+	t.Helper()
+
+	count := 0
+	for k, v := range m.Iterate() {
+		inverse, ok := m.GetByValue(v)
+		assert.True(t, ok)
+		assert.Equal(t, k, inverse)
+		count++
+	}
+	assert.Equal(t, m.Len(), count)
+	assert.Equal(t, len(m.forward), len(m.inverse))
+}
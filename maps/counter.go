@@ -0,0 +1,168 @@
+package maps
+
+import (
+	"cmp"
+	"iter"
+	"slices"
+)
+
+// ValueCount is a value with its number of occurrences.
+type ValueCount[T any] struct {
+	Value T
+	Count int
+}
+
+// Counter counts occurrences of values.
+// Values are kept in the order they are first counted, which breaks ties between equal counts.
+// Values with a count that drops to zero or below are removed.
+// To use this object, it is absolutely necessary to create the object using the NewCounter constructor.
+type Counter[T comparable] struct {
+	counts *OrderedMap[T, int]
+	total  int
+}
+
+// NewCounter allocates and initializes new object of type Counter and returns a pointer to it.
+// It is absolutely necessary to create the Counter object using this constructor.
+func NewCounter[T comparable](size int) *Counter[T] {
+	c := Counter[T]{
+		counts: NewOrderedMap[T, int](size),
+	}
+
+	return &c
+}
+
+// Len returns the number of distinct values.
+func (c *Counter[_]) Len() int {
+	if c == nil {
+		return 0
+	}
+
+	return c.counts.Len()
+}
+
+// Total returns the sum of all counts.
+func (c *Counter[_]) Total() int {
+	if c == nil {
+		return 0
+	}
+
+	return c.total
+}
+
+// Get returns the count of the given value, zero if the value is not counted.
+func (c *Counter[T]) Get(v T) int {
+	if c == nil {
+		return 0
+	}
+
+	return c.counts.GetValue(v)
+}
+
+// Add increments the count of every given value by one.
+// Panics if c is not initialized by NewCounter constructor.
+func (c *Counter[T]) Add(values ...T) {
+	for _, v := range values {
+		c.AddN(v, 1)
+	}
+}
+
+// AddN adds n to the count of the given value. If the count drops to zero or below, the value is removed.
+// Panics if c is not initialized by NewCounter constructor.
+func (c *Counter[T]) AddN(v T, n int) {
+	count := c.counts.GetValue(v) + n
+	if count <= 0 {
+		c.Delete(v)
+		return
+	}
+
+	c.total += n
+	c.counts.Set(v, count)
+}
+
+// Delete removes the given value from the counter.
+func (c *Counter[T]) Delete(v T) {
+	if c == nil {
+		return
+	}
+
+	if count, ok := c.counts.GetAndCheck(v); ok {
+		c.total -= count
+		c.counts.Delete(v)
+	}
+}
+
+// Merge adds the counts of other to the counts of c.
+// Panics if c is not initialized by NewCounter constructor.
+func (c *Counter[T]) Merge(other *Counter[T]) {
+	for v, n := range other.Iterate() {
+		c.AddN(v, n)
+	}
+}
+
+// Subtract subtracts the counts of other from the counts of c, removing values with counts dropped to zero or below.
+// Panics if c is not initialized by NewCounter constructor.
+func (c *Counter[T]) Subtract(other *Counter[T]) {
+	for v, n := range other.Iterate() {
+		c.AddN(v, -n)
+	}
+}
+
+// Iterate iterates over values and their counts in the order values are first counted.
+func (c *Counter[T]) Iterate() iter.Seq2[T, int] {
+	if c == nil {
+		return (*OrderedMap[T, int])(nil).Iterate()
+	}
+
+	return c.counts.Iterate()
+}
+
+// Pairs returns the values with their counts in the order values are first counted.
+func (c *Counter[T]) Pairs() []ValueCount[T] {
+	pairs := make([]ValueCount[T], 0, c.Len())
+	for v, n := range c.Iterate() {
+		pairs = append(pairs, ValueCount[T]{Value: v, Count: n})
+	}
+
+	return pairs
+}
+
+// MostCommon returns n values with the largest counts in descending order of counts.
+// Values with equal counts are ordered by the first occurrence. n <= 0 means all values.
+func (c *Counter[T]) MostCommon(n int) []ValueCount[T] {
+	pairs := c.Pairs()
+	slices.SortStableFunc(pairs, func(a, b ValueCount[T]) int {
+		return cmp.Compare(b.Count, a.Count)
+	})
+
+	return firstPairs(pairs, n)
+}
+
+// LeastCommon returns n values with the smallest counts in ascending order of counts.
+// Values with equal counts are ordered by the first occurrence. n <= 0 means all values.
+func (c *Counter[T]) LeastCommon(n int) []ValueCount[T] {
+	pairs := c.Pairs()
+	slices.SortStableFunc(pairs, func(a, b ValueCount[T]) int {
+		return cmp.Compare(a.Count, b.Count)
+	})
+
+	return firstPairs(pairs, n)
+}
+
+// SortedByValue returns the values of the counter with their counts in ascending order of values.
+func SortedByValue[T cmp.Ordered](c *Counter[T]) []ValueCount[T] {
+	pairs := c.Pairs()
+	slices.SortFunc(pairs, func(a, b ValueCount[T]) int {
+		return cmp.Compare(a.Value, b.Value)
+	})
+
+	return pairs
+}
+
+// firstPairs returns the first n pairs, all pairs if n <= 0.
+func firstPairs[T any](pairs []ValueCount[T], n int) []ValueCount[T] {
+	if n <= 0 || n >= len(pairs) {
+		return pairs
+	}
+
+	return slices.Clip(pairs[:n])
+}
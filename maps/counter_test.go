@@ -0,0 +1,113 @@
+package maps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCounter_NotInitialized(t *testing.T) {
+	// Counter is nil
+	var c *Counter[string]
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 0, c.Total())
+	assert.Equal(t, 0, c.Get("a"))
+	assert.NotPanics(t, func() { c.Delete("a") })
+	assert.Panics(t, func() { c.Add("a") })
+	assert.Empty(t, c.Pairs())
+	assert.Empty(t, c.MostCommon(1))
+	for v, n := range c.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, value=%s, count=%d", v, n))
+	}
+
+	// Counter is initialized without constructor
+	c = &Counter[string]{}
+	assert.Equal(t, 0, c.Len())
+	assert.Equal(t, 0, c.Get("a"))
+	assert.Panics(t, func() { c.Add("a") })
+
+	// Merge of nil counter
+	c = NewCounter[string](0)
+	c.Merge(nil)
+	c.Subtract(nil)
+	assert.Equal(t, 0, c.Len())
+}
+
+func TestCounter(t *testing.T) {
+	c := NewCounter[string](0)
+	c.Add("b", "a", "b", "c", "b", "a")
+	c.AddN("d", 2)
+
+	assert.Equal(t, 4, c.Len())
+	assert.Equal(t, 8, c.Total())
+	assert.Equal(t, 3, c.Get("b"))
+	assert.Equal(t, 2, c.Get("a"))
+	assert.Equal(t, 0, c.Get("x"))
+	assert.Equal(t, []ValueCount[string]{{"b", 3}, {"a", 2}, {"c", 1}, {"d", 2}}, c.Pairs())
+
+	// Count drops to zero
+	c.AddN("c", -1)
+	assert.Equal(t, 0, c.Get("c"))
+	assert.Equal(t, 3, c.Len())
+	assert.Equal(t, 7, c.Total())
+
+	// Count drops below zero
+	c.AddN("a", -5)
+	assert.Equal(t, 0, c.Get("a"))
+	assert.Equal(t, 5, c.Total())
+
+	// Negative count of an absent value
+	c.AddN("x", -1)
+	assert.Equal(t, 0, c.Get("x"))
+	assert.Equal(t, 5, c.Total())
+
+	c.Delete("b")
+	assert.Equal(t, 2, c.Total())
+	assert.Equal(t, []ValueCount[string]{{"d", 2}}, c.Pairs())
+}
+
+func TestCounter_MostCommon(t *testing.T) {
+	c := NewCounter[string](0)
+	c.Add("x", "a", "b", "a", "c", "b", "a", "d")
+
+	// Ties are ordered by the first occurrence
+	assert.Equal(t, []ValueCount[string]{{"a", 3}, {"b", 2}, {"x", 1}, {"c", 1}, {"d", 1}}, c.MostCommon(0))
+	assert.Equal(t, []ValueCount[string]{{"a", 3}, {"b", 2}}, c.MostCommon(2))
+	assert.Len(t, c.MostCommon(10), 5)
+
+	assert.Equal(t, []ValueCount[string]{{"x", 1}, {"c", 1}, {"d", 1}, {"b", 2}, {"a", 3}}, c.LeastCommon(-1))
+	assert.Equal(t, []ValueCount[string]{{"x", 1}}, c.LeastCommon(1))
+
+	// Appending to the result doesn't overwrite other pairs
+	top := c.MostCommon(1)
+	assert.Equal(t, 1, cap(top))
+}
+
+func TestCounter_MergeSubtract(t *testing.T) {
+	c1 := NewCounter[int](0)
+	c1.Add(1, 1, 2, 3)
+	c2 := NewCounter[int](0)
+	c2.Add(3, 3, 4, 1)
+
+	c1.Merge(c2)
+	assert.Equal(t, []ValueCount[int]{{1, 3}, {2, 1}, {3, 3}, {4, 1}}, c1.Pairs())
+	assert.Equal(t, 8, c1.Total())
+
+	c2.AddN(2, 5)
+	c1.Subtract(c2)
+	assert.Equal(t, []ValueCount[int]{{1, 2}, {3, 1}}, c1.Pairs())
+	assert.Equal(t, 3, c1.Total())
+
+	// Other counter is not changed
+	assert.Equal(t, 9, c2.Total())
+}
+
+func TestSortedByValue(t *testing.T) {
+	c := NewCounter[string](0)
+	c.Add("c", "a", "b", "a")
+
+	assert.Equal(t, []ValueCount[string]{{"a", 2}, {"b", 1}, {"c", 1}}, SortedByValue(c))
+	assert.Empty(t, SortedByValue[string](nil))
+}
@@ -0,0 +1,99 @@
+package maps
+
+import (
+	"reflect"
+	"slices"
+)
+
+// MergeResolver chooses the value for a key present in two merged layers.
+// path contains the keys from the root map to the conflicting key, left is the value from the lower-priority
+// layers and right is the value from the layer being merged.
+// Values are conflicting unless both of them are nested maps of type map[K]any, which are merged recursively.
+type MergeResolver[K comparable] func(path []K, left, right any) any
+
+// PreferLeft is a MergeResolver that keeps the value from the earlier layers.
+func PreferLeft[K comparable](_ []K, left, _ any) any {
+	return left
+}
+
+// PreferRight is a MergeResolver that takes the value from the later layer.
+func PreferRight[K comparable](_ []K, _, right any) any {
+	return right
+}
+
+// AppendSlices is a MergeResolver that appends the right slice to the left one if both values are slices
+// of the same type, otherwise it takes the value from the later layer.
+func AppendSlices[K comparable](_ []K, left, right any) any {
+	l, r := reflect.ValueOf(left), reflect.ValueOf(right)
+	if l.Kind() != reflect.Slice || r.Kind() != reflect.Slice || l.Type() != r.Type() {
+		return right
+	}
+
+	result := reflect.MakeSlice(l.Type(), 0, l.Len()+r.Len())
+	result = reflect.AppendSlice(result, l)
+	result = reflect.AppendSlice(result, r)
+
+	return result.Interface()
+}
+
+// DeepMerge merges the layers from left to right into a new map, recursing into nested maps of type map[K]any.
+// Conflicting values are resolved by resolve, nil resolve means PreferRight.
+// Nested maps of the result are copies, so the layers are not modified by changing the result.
+func DeepMerge[K comparable](resolve MergeResolver[K], layers ...map[K]any) map[K]any {
+	if resolve == nil {
+		resolve = PreferRight[K]
+	}
+
+	size := 0
+	for _, layer := range layers {
+		size = max(size, len(layer))
+	}
+
+	result := make(map[K]any, size)
+	for _, layer := range layers {
+		deepMergeInto(result, layer, nil, resolve)
+	}
+
+	return result
+}
+
+// deepMergeInto merges the src map into the dst map, which is owned by the result.
+func deepMergeInto[K comparable](dst, src map[K]any, path []K, resolve MergeResolver[K]) {
+	for k, right := range src {
+		left, ok := dst[k]
+		if !ok {
+			dst[k] = deepCopyValue[K](right)
+			continue
+		}
+
+		keyPath := append(slices.Clip(path), k)
+
+		leftMap, leftOk := left.(map[K]any)
+		rightMap, rightOk := right.(map[K]any)
+		if leftOk && rightOk {
+			if leftMap == nil {
+				leftMap = make(map[K]any, len(rightMap))
+				dst[k] = leftMap
+			}
+			deepMergeInto(leftMap, rightMap, keyPath, resolve)
+			continue
+		}
+
+		dst[k] = deepCopyValue[K](resolve(keyPath, left, right))
+	}
+}
+
+// deepCopyValue copies nested maps of type map[K]any, other values are returned as is.
+func deepCopyValue[K comparable](v any) any {
+	m, ok := v.(map[K]any)
+	if !ok || m == nil {
+		return v
+	}
+
+	result := make(map[K]any, len(m))
+	for k, v := range m {
+		result[k] = deepCopyValue[K](v)
+	}
+
+	return result
+}
@@ -0,0 +1,158 @@
+package maps
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func deepMergeLayers() (defaults, env, overrides map[string]any) {
+	defaults = map[string]any{
+		"name": "service",
+		"db": map[string]any{
+			"host":  "localhost",
+			"port":  5432,
+			"hosts": []string{"db1"},
+		},
+		"tags": []any{"default"},
+	}
+	env = map[string]any{
+		"db": map[string]any{
+			"host":  "db.prod",
+			"hosts": []string{"db2"},
+			"pool":  map[string]any{"size": 10},
+		},
+		"tags": []any{"prod"},
+	}
+	overrides = map[string]any{
+		"name": "override",
+		"db": map[string]any{
+			"pool": map[string]any{"size": 20, "timeout": "1s"},
+		},
+		"tags": "none",
+	}
+
+	return defaults, env, overrides
+}
+
+func TestDeepMerge_PreferRight(t *testing.T) {
+	defaults, env, overrides := deepMergeLayers()
+
+	result := DeepMerge(PreferRight[string], defaults, env, overrides)
+	assert.Equal(t, map[string]any{
+		"name": "override",
+		"db": map[string]any{
+			"host":  "db.prod",
+			"port":  5432,
+			"hosts": []string{"db2"},
+			"pool":  map[string]any{"size": 20, "timeout": "1s"},
+		},
+		"tags": "none",
+	}, result)
+
+	// Nil resolver prefers right
+	assert.Equal(t, result, DeepMerge(nil, defaults, env, overrides))
+}
+
+func TestDeepMerge_PreferLeft(t *testing.T) {
+	defaults, env, overrides := deepMergeLayers()
+
+	result := DeepMerge(PreferLeft[string], defaults, env, overrides)
+	assert.Equal(t, map[string]any{
+		"name": "service",
+		"db": map[string]any{
+			"host":  "localhost",
+			"port":  5432,
+			"hosts": []string{"db1"},
+			"pool":  map[string]any{"size": 10, "timeout": "1s"},
+		},
+		"tags": []any{"default"},
+	}, result)
+}
+
+func TestDeepMerge_AppendSlices(t *testing.T) {
+	defaults, env, overrides := deepMergeLayers()
+
+	result := DeepMerge(AppendSlices[string], defaults, env)
+	assert.Equal(t, []string{"db1", "db2"}, result["db"].(map[string]any)["hosts"])
+	assert.Equal(t, []any{"default", "prod"}, result["tags"])
+
+	// Input slices are not modified
+	assert.Equal(t, []string{"db1"}, defaults["db"].(map[string]any)["hosts"])
+
+	// Not slices or slices of different types are taken from the right
+	result = DeepMerge(AppendSlices[string], defaults, env, overrides)
+	assert.Equal(t, "none", result["tags"])
+	assert.Equal(t, "override", result["name"])
+	assert.Equal(t, []int{1}, AppendSlices[string](nil, []string{"a"}, []int{1}))
+}
+
+func TestDeepMerge_CustomResolver(t *testing.T) {
+	defaults, env, overrides := deepMergeLayers()
+
+	var paths []string
+	result := DeepMerge(func(path []string, left, right any) any {
+		paths = append(paths, strings.Join(path, "."))
+
+		// Keep the default host, append tags, take the rest from the right
+		switch strings.Join(path, ".") {
+		case "db.host":
+			return left
+		case "tags":
+			return AppendSlices(path, left, right)
+		}
+
+		return right
+	}, defaults, env, overrides)
+
+	assert.ElementsMatch(t, []string{"db.host", "db.hosts", "tags", "name", "db.pool.size", "tags"}, paths)
+	assert.Equal(t, "localhost", result["db"].(map[string]any)["host"])
+	assert.Equal(t, []string{"db2"}, result["db"].(map[string]any)["hosts"])
+	assert.Equal(t, "none", result["tags"])
+	assert.Equal(t, 20, result["db"].(map[string]any)["pool"].(map[string]any)["size"])
+}
+
+func TestDeepMerge_MapConflicts(t *testing.T) {
+	// Map replaced by a scalar and vice versa
+	a := map[string]any{"x": map[string]any{"y": 1}, "z": 1}
+	b := map[string]any{"x": 2, "z": map[string]any{"w": 3}}
+	assert.Equal(t, map[string]any{"x": 2, "z": map[string]any{"w": 3}}, DeepMerge(nil, a, b))
+	assert.Equal(t, map[string]any{"x": map[string]any{"y": 1}, "z": 1}, DeepMerge(PreferLeft[string], a, b))
+
+	// Nil nested map is merged as an empty one
+	a = map[string]any{"x": map[string]any(nil)}
+	b = map[string]any{"x": map[string]any{"y": 1}}
+	assert.Equal(t, map[string]any{"x": map[string]any{"y": 1}}, DeepMerge(nil, a, b))
+}
+
+func TestDeepMerge_DoesNotModifyLayers(t *testing.T) {
+	defaults, env, overrides := deepMergeLayers()
+
+	result := DeepMerge(nil, defaults, env, overrides)
+	result["db"].(map[string]any)["host"] = "changed"
+	result["db"].(map[string]any)["pool"].(map[string]any)["size"] = 0
+
+	assert.Equal(t, "localhost", defaults["db"].(map[string]any)["host"])
+	assert.Equal(t, "db.prod", env["db"].(map[string]any)["host"])
+	assert.Equal(t, 10, env["db"].(map[string]any)["pool"].(map[string]any)["size"])
+	assert.Equal(t, 20, overrides["db"].(map[string]any)["pool"].(map[string]any)["size"])
+}
+
+func TestDeepMerge_Empty(t *testing.T) {
+	assert.Equal(t, map[string]any{}, DeepMerge[string](nil))
+	assert.Equal(t, map[string]any{}, DeepMerge[string](nil, nil, map[string]any{}))
+
+	// Non-string keys
+	result := DeepMerge(PreferRight[int], map[int]any{1: map[int]any{2: "a"}}, map[int]any{1: map[int]any{3: "b"}})
+	assert.Equal(t, map[int]any{1: map[int]any{2: "a", 3: "b"}}, result)
+}
+
+func BenchmarkDeepMerge(b *testing.B) {
+	defaults, env, overrides := deepMergeLayers()
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		DeepMerge(AppendSlices[string], defaults, env, overrides)
+	}
+}
@@ -0,0 +1,67 @@
+package maps
+
+// ValueChange contains the old and the new value of a changed map element.
+type ValueChange[V any] struct {
+	Old V
+	New V
+}
+
+// DiffResult describes the changes that turn one map into another.
+type DiffResult[K comparable, V any] struct {
+	Added   map[K]V              // elements present only in the new map
+	Removed map[K]V              // elements present only in the old map, with their old values
+	Changed map[K]ValueChange[V] // elements present in both maps with different values
+}
+
+// Diff compares the old map "from" with the new map "to" and returns added, removed and changed elements.
+func Diff[K, V comparable](from, to map[K]V) DiffResult[K, V] {
+	return DiffFunc(from, to, func(a, b V) bool {
+		return a == b
+	})
+}
+
+// DiffFunc like Diff, compares two maps using the given function to check if values are equal.
+// Can be used for values that are not comparable.
+func DiffFunc[K comparable, V any](from, to map[K]V, equal func(a, b V) bool) DiffResult[K, V] {
+	d := DiffResult[K, V]{
+		Added:   make(map[K]V),
+		Removed: make(map[K]V),
+		Changed: make(map[K]ValueChange[V]),
+	}
+
+	for k, old := range from {
+		v, ok := to[k]
+		switch {
+		case !ok:
+			d.Removed[k] = old
+		case !equal(old, v):
+			d.Changed[k] = ValueChange[V]{Old: old, New: v}
+		}
+	}
+	for k, v := range to {
+		if _, ok := from[k]; !ok {
+			d.Added[k] = v
+		}
+	}
+
+	return d
+}
+
+// IsEmpty checks if the diff contains no changes.
+func (d DiffResult[_, _]) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Apply replays the diff onto the map: sets added and changed elements and deletes removed ones.
+// Modifies the given map. Panics if m is nil and the diff adds or changes elements.
+func (d DiffResult[K, V]) Apply(m map[K]V) {
+	for k := range d.Removed {
+		delete(m, k)
+	}
+	for k, v := range d.Added {
+		m[k] = v
+	}
+	for k, c := range d.Changed {
+		m[k] = c.New
+	}
+}
@@ -0,0 +1,79 @@
+package maps
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiff(t *testing.T) {
+	from := map[string]int{"apples": 2, "bananas": 5, "lemon": 1}
+	to := map[string]int{"apples": 3, "lemon": 1, "oranges": 7}
+
+	d := Diff(from, to)
+	assert.Equal(t, map[string]int{"oranges": 7}, d.Added)
+	assert.Equal(t, map[string]int{"bananas": 5}, d.Removed)
+	assert.Equal(t, map[string]ValueChange[int]{"apples": {Old: 2, New: 3}}, d.Changed)
+	assert.False(t, d.IsEmpty())
+
+	// Equal maps
+	d = Diff(from, map[string]int{"apples": 2, "bananas": 5, "lemon": 1})
+	assert.True(t, d.IsEmpty())
+	assert.Equal(t, map[string]int{}, d.Added)
+
+	// Nil maps
+	d = Diff[string, int](nil, nil)
+	assert.True(t, d.IsEmpty())
+
+	d = Diff(nil, to)
+	assert.Equal(t, to, d.Added)
+	assert.Empty(t, d.Removed)
+
+	d = Diff(from, nil)
+	assert.Equal(t, from, d.Removed)
+	assert.Empty(t, d.Added)
+}
+
+func TestDiffFunc(t *testing.T) {
+	from := map[int][]string{1: {"a", "b"}, 2: {"c"}, 3: nil}
+	to := map[int][]string{1: {"a", "b"}, 2: {"c", "d"}, 4: {"e"}}
+
+	d := DiffFunc(from, to, slices.Equal[[]string])
+	assert.Equal(t, map[int][]string{4: {"e"}}, d.Added)
+	assert.Equal(t, map[int][]string{3: nil}, d.Removed)
+	assert.Equal(t, map[int]ValueChange[[]string]{2: {Old: []string{"c"}, New: []string{"c", "d"}}}, d.Changed)
+}
+
+func TestDiffResult_Apply(t *testing.T) {
+	from := map[string]int{"apples": 2, "bananas": 5, "lemon": 1}
+	to := map[string]int{"apples": 3, "lemon": 1, "oranges": 7}
+
+	// Replaying the diff onto the old map gives the new map
+	m := Merge(from, nil)
+	Diff(from, to).Apply(m)
+	assert.Equal(t, to, m)
+
+	// Empty diff doesn't change the map
+	Diff(to, to).Apply(m)
+	assert.Equal(t, to, m)
+
+	// Removing from a nil map is allowed
+	var nilMap map[string]int
+	assert.NotPanics(t, func() { Diff(from, nil).Apply(nilMap) })
+	assert.Panics(t, func() { Diff(nil, to).Apply(nilMap) })
+}
+
+func BenchmarkDiff(b *testing.B) {
+	from := make(map[int]int, 1000)
+	to := make(map[int]int, 1000)
+	for i := 0; i < 1000; i++ {
+		from[i] = i
+		to[i+100] = i % 3
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		Diff(from, to)
+	}
+}
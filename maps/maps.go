@@ -1,5 +1,13 @@
 package maps
 
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
 // Has checks if the map contains the given key.
 func Has[K comparable, V any](m map[K]V, key K) bool {
 	_, ok := m[key]
@@ -46,3 +54,167 @@ func FilterByKeys[K comparable, V any](m map[K]V, keys []K) map[K]V {
 
 	return result
 }
+
+// ErrDuplicateKey is returned when different keys are mapped to the same key.
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ErrDuplicateValue is returned when a map is inverted and different keys have the same value.
+var ErrDuplicateValue = errors.New("duplicate value")
+
+// MapValues returns a new map with the same keys and values converted by fn.
+func MapValues[K comparable, V, R any](m map[K]V, fn func(V) R) map[K]R {
+	result := make(map[K]R, len(m))
+
+	for k, v := range m {
+		result[k] = fn(v)
+	}
+
+	return result
+}
+
+// MapKeys returns a new map with keys converted by fn and the same values.
+// Returns ErrDuplicateKey if fn returns the same key for different keys.
+func MapKeys[K, R comparable, V any](m map[K]V, fn func(K) R) (map[R]V, error) {
+	result := make(map[R]V, len(m))
+
+	for k, v := range m {
+		r := fn(k)
+		if _, ok := result[r]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateKey, r)
+		}
+		result[r] = v
+	}
+
+	return result, nil
+}
+
+// MapKeysFunc like MapKeys, returns a new map with keys converted by fn.
+// If fn returns the same key for different keys, their values are combined by resolve.
+// The order in which colliding values are passed to resolve is not specified.
+func MapKeysFunc[K, R comparable, V any](m map[K]V, fn func(K) R, resolve func(key R, existing, v V) V) map[R]V {
+	result := make(map[R]V, len(m))
+
+	for k, v := range m {
+		r := fn(k)
+		if existing, ok := result[r]; ok {
+			v = resolve(r, existing, v)
+		}
+		result[r] = v
+	}
+
+	return result
+}
+
+// Filter returns a new map containing only elements for which the predicate returns true.
+func Filter[K comparable, V any](m map[K]V, predicate func(K, V) bool) map[K]V {
+	result := make(map[K]V)
+
+	for k, v := range m {
+		if predicate(k, v) {
+			result[k] = v
+		}
+	}
+
+	return result
+}
+
+// Partition splits the map into two new maps: elements for which the predicate returns true and the rest.
+func Partition[K comparable, V any](m map[K]V, predicate func(K, V) bool) (matched, rest map[K]V) {
+	matched = make(map[K]V)
+	rest = make(map[K]V)
+
+	for k, v := range m {
+		if predicate(k, v) {
+			matched[k] = v
+		} else {
+			rest[k] = v
+		}
+	}
+
+	return matched, rest
+}
+
+// Invert returns a new map where the values are the keys and the keys are the values.
+// Returns ErrDuplicateValue if different keys have the same value.
+func Invert[K, V comparable](m map[K]V) (map[V]K, error) {
+	result := make(map[V]K, len(m))
+
+	for k, v := range m {
+		if _, ok := result[v]; ok {
+			return nil, fmt.Errorf("%w: %v", ErrDuplicateValue, v)
+		}
+		result[v] = k
+	}
+
+	return result, nil
+}
+
+// InvertMulti returns a new map where the values are the keys and the values are slices of keys with that value.
+// The order of keys in the slices is not specified.
+func InvertMulti[K, V comparable](m map[K]V) map[V][]K {
+	result := make(map[V][]K, len(m))
+
+	for k, v := range m {
+		result[v] = append(result[v], k)
+	}
+
+	return result
+}
+
+// Reduce accumulates the map elements into a single value, starting with init.
+// The order of elements is not specified, so fn should not depend on it.
+func Reduce[K comparable, V, R any](m map[K]V, init R, fn func(acc R, k K, v V) R) R {
+	acc := init
+
+	for k, v := range m {
+		acc = fn(acc, k, v)
+	}
+
+	return acc
+}
+
+// Keys returns an iterator over the map keys. The order is not specified.
+func Keys[K comparable, V any](m map[K]V) iter.Seq[K] {
+	return func(yield func(K) bool) {
+		for k := range m {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns an iterator over the map values. The order is not specified.
+func Values[K comparable, V any](m map[K]V) iter.Seq[V] {
+	return func(yield func(V) bool) {
+		for _, v := range m {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SortedKeys returns a slice of the map keys in ascending order.
+func SortedKeys[K cmp.Ordered, V any](m map[K]V) []K {
+	keys := make([]K, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	return keys
+}
+
+// SortedValues returns a slice of the map values in ascending order.
+func SortedValues[K comparable, V cmp.Ordered](m map[K]V) []V {
+	values := make([]V, 0, len(m))
+
+	for _, v := range m {
+		values = append(values, v)
+	}
+	slices.Sort(values)
+
+	return values
+}
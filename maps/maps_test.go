@@ -1,6 +1,7 @@
 package maps
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -161,3 +162,145 @@ func BenchmarkFilterByKeys(b *testing.B) {
 		FilterByKeys(m, keys)
 	}
 }
+
+func TestMapValues(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2}
+	result := MapValues(m, func(v int) string { return strings.Repeat("*", v) })
+	assert.Equal(t, map[string]string{"one": "*", "two": "**"}, result)
+
+	// Nil map
+	assert.Equal(t, map[string]string{}, MapValues(map[string]int(nil), func(v int) string { return "" }))
+}
+
+func TestMapKeys(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2}
+	result, err := MapKeys(m, strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{"ONE": 1, "TWO": 2}, result)
+
+	// Collision
+	m = map[string]int{"one": 1, "ONE": 2}
+	result, err = MapKeys(m, strings.ToUpper)
+	assert.ErrorIs(t, err, ErrDuplicateKey)
+	assert.Nil(t, result)
+
+	// Nil map
+	result, err = MapKeys(map[string]int(nil), strings.ToUpper)
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]int{}, result)
+}
+
+func TestMapKeysFunc(t *testing.T) {
+	m := map[string]int{"one": 1, "ONE": 2, "two": 3}
+	result := MapKeysFunc(m, strings.ToLower, func(key string, existing, v int) int {
+		assert.Equal(t, "one", key)
+		return existing + v
+	})
+	assert.Equal(t, map[string]int{"one": 3, "two": 3}, result)
+}
+
+func TestFilter(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+	result := Filter(m, func(k string, v int) bool { return v%2 == 0 })
+	assert.Equal(t, map[string]int{"two": 2, "four": 4}, result)
+
+	result = Filter(m, func(k string, v int) bool { return len(k) > 10 })
+	assert.Equal(t, map[string]int{}, result)
+}
+
+func TestPartition(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2, "three": 3, "four": 4}
+	even, odd := Partition(m, func(k string, v int) bool { return v%2 == 0 })
+	assert.Equal(t, map[string]int{"two": 2, "four": 4}, even)
+	assert.Equal(t, map[string]int{"one": 1, "three": 3}, odd)
+
+	// Nil map
+	even, odd = Partition(map[string]int(nil), func(k string, v int) bool { return v%2 == 0 })
+	assert.Equal(t, map[string]int{}, even)
+	assert.Equal(t, map[string]int{}, odd)
+}
+
+func TestInvert(t *testing.T) {
+	m := map[string]int{"one": 1, "two": 2}
+	result, err := Invert(m)
+	assert.NoError(t, err)
+	assert.Equal(t, map[int]string{1: "one", 2: "two"}, result)
+
+	// Duplicate values
+	m = map[string]int{"one": 1, "uno": 1}
+	result, err = Invert(m)
+	assert.ErrorIs(t, err, ErrDuplicateValue)
+	assert.Nil(t, result)
+}
+
+func TestInvertMulti(t *testing.T) {
+	m := map[string]int{"one": 1, "uno": 1, "two": 2}
+	result := InvertMulti(m)
+	assert.Len(t, result, 2)
+	assert.ElementsMatch(t, []string{"one", "uno"}, result[1])
+	assert.Equal(t, []string{"two"}, result[2])
+}
+
+func TestReduce(t *testing.T) {
+	m := map[string]int{"apples": 2, "bananas": 5, "lemon": 1}
+	total := Reduce(m, 0, func(acc int, k string, v int) int { return acc + v })
+	assert.Equal(t, 8, total)
+
+	// Nil map returns init
+	assert.Equal(t, 10, Reduce(map[string]int(nil), 10, func(acc int, k string, v int) int { return acc + v }))
+}
+
+func TestKeysValues(t *testing.T) {
+	m := map[string]int{"b": 2, "c": 1, "a": 3}
+
+	keys := make([]string, 0, len(m))
+	for k := range Keys(m) {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, keys)
+
+	values := make([]int, 0, len(m))
+	for v := range Values(m) {
+		values = append(values, v)
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3}, values)
+
+	// Break the loop
+	for range Keys(m) {
+		break
+	}
+
+	assert.Equal(t, []string{"a", "b", "c"}, SortedKeys(m))
+	assert.Equal(t, []int{1, 2, 3}, SortedValues(m))
+
+	// Nil map
+	assert.Equal(t, []string{}, SortedKeys[string, int](nil))
+	assert.Equal(t, []int{}, SortedValues[string, int](nil))
+	for k := range Keys[string, int](nil) {
+		assert.Fail(t, "iterate over nil map", k)
+	}
+}
+
+func BenchmarkMapValues(b *testing.B) {
+	m := make(map[int]int, 1000)
+	for i := 0; i < 1000; i++ {
+		m[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		MapValues(m, func(v int) int { return v * 2 })
+	}
+}
+
+func BenchmarkSortedKeys(b *testing.B) {
+	m := make(map[int]int, 1000)
+	for i := 0; i < 1000; i++ {
+		m[i] = i
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		SortedKeys(m)
+	}
+}
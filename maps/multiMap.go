@@ -0,0 +1,153 @@
+package maps
+
+import (
+	"iter"
+	"slices"
+)
+
+// MultiMap represents a map where every key may have several values.
+// Values of a key are kept in the order they are added.
+// To use this object, it is absolutely necessary to create the object using the NewMultiMap constructor.
+type MultiMap[K, V comparable] struct {
+	data   map[K][]V
+	length int
+}
+
+// NewMultiMap allocates and initializes new object of type MultiMap and returns a pointer to it.
+// It is absolutely necessary to create the MultiMap object using this constructor.
+func NewMultiMap[K, V comparable](size int) *MultiMap[K, V] {
+	m := MultiMap[K, V]{
+		data: make(map[K][]V, size),
+	}
+
+	return &m
+}
+
+// Len returns the number of key-value pairs in the map.
+func (m *MultiMap[_, _]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	return m.length
+}
+
+// KeysLen returns the number of keys in the map.
+func (m *MultiMap[_, _]) KeysLen() int {
+	if m == nil {
+		return 0
+	}
+
+	return len(m.data)
+}
+
+// Count returns the number of values by the given key.
+func (m *MultiMap[K, _]) Count(k K) int {
+	if m == nil {
+		return 0
+	}
+
+	return len(m.data[k])
+}
+
+// Has checks if the map contains the given key.
+func (m *MultiMap[K, _]) Has(k K) bool {
+	return m.Count(k) > 0
+}
+
+// HasValue checks if the map contains the given value by the given key.
+func (m *MultiMap[K, V]) HasValue(k K, v V) bool {
+	if m == nil {
+		return false
+	}
+
+	return slices.Contains(m.data[k], v)
+}
+
+// Get returns a copy of the values by the given key.
+// If m is nil or k is not found, returns nil.
+func (m *MultiMap[K, V]) Get(k K) []V {
+	if m == nil {
+		return nil
+	}
+
+	return slices.Clone(m.data[k])
+}
+
+// Add adds the given values by the given key.
+// Panics if m is not initialized by NewMultiMap constructor.
+func (m *MultiMap[K, V]) Add(k K, vv ...V) {
+	if len(vv) == 0 {
+		return
+	}
+
+	m.data[k] = append(m.data[k], vv...)
+	m.length += len(vv)
+}
+
+// Remove removes the first occurrence of the given value by the given key.
+// Returns false if the value is not found.
+func (m *MultiMap[K, V]) Remove(k K, v V) bool {
+	if m == nil {
+		return false
+	}
+
+	values := m.data[k]
+	i := slices.Index(values, v)
+	if i < 0 {
+		return false
+	}
+
+	if len(values) == 1 {
+		delete(m.data, k)
+	} else {
+		m.data[k] = slices.Delete(values, i, i+1)
+	}
+	m.length--
+
+	return true
+}
+
+// Delete deletes all values by the given key and returns their number.
+func (m *MultiMap[K, _]) Delete(k K) int {
+	if m == nil {
+		return 0
+	}
+
+	n := len(m.data[k])
+	delete(m.data, k)
+	m.length -= n
+
+	return n
+}
+
+// Keys returns an iterator over the map keys. The order is not specified.
+func (m *MultiMap[K, V]) Keys() iter.Seq[K] {
+	return func(yield func(K) bool) {
+		if m == nil {
+			return
+		}
+		for k := range m.data {
+			if !yield(k) {
+				return
+			}
+		}
+	}
+}
+
+// Iterate iterates over all key-value pairs of the map.
+// The order of keys is not specified, values of a key are produced in the order they are added.
+func (m *MultiMap[K, V]) Iterate() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+		for k, values := range m.data {
+			for _, v := range values {
+				if !yield(k, v) {
+					return
+				}
+			}
+		}
+	}
+}
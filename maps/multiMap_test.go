@@ -0,0 +1,102 @@
+package maps
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMultiMap_NotInitialized(t *testing.T) {
+	// Map is nil
+	var m *MultiMap[string, int]
+	assert.Equal(t, 0, m.Len())
+	assert.Equal(t, 0, m.KeysLen())
+	assert.Equal(t, 0, m.Count("a"))
+	assert.False(t, m.Has("a"))
+	assert.False(t, m.HasValue("a", 1))
+	assert.Nil(t, m.Get("a"))
+	assert.False(t, m.Remove("a", 1))
+	assert.Equal(t, 0, m.Delete("a"))
+	assert.Panics(t, func() { m.Add("a", 1) })
+	for k, v := range m.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%s, value='%d'", k, v))
+	}
+	for k := range m.Keys() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%s", k))
+	}
+
+	// Map is initialized without constructor
+	m = &MultiMap[string, int]{}
+	assert.Equal(t, 0, m.Len())
+	assert.Nil(t, m.Get("a"))
+	assert.Panics(t, func() { m.Add("a", 1) })
+}
+
+func TestMultiMap(t *testing.T) {
+	m := NewMultiMap[string, int](2)
+	m.Add("a", 1, 2)
+	m.Add("a", 1)
+	m.Add("b", 3)
+	m.Add("c")
+
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, 2, m.KeysLen())
+	assert.Equal(t, 3, m.Count("a"))
+	assert.True(t, m.Has("b"))
+	assert.False(t, m.Has("c"))
+	assert.True(t, m.HasValue("a", 2))
+	assert.False(t, m.HasValue("b", 2))
+	assert.Equal(t, []int{1, 2, 1}, m.Get("a"))
+	assert.Nil(t, m.Get("c"))
+
+	// Get returns a copy
+	m.Get("a")[0] = 100
+	assert.Equal(t, []int{1, 2, 1}, m.Get("a"))
+
+	// Remove the first occurrence
+	assert.True(t, m.Remove("a", 1))
+	assert.Equal(t, []int{2, 1}, m.Get("a"))
+	assert.False(t, m.Remove("a", 5))
+	assert.False(t, m.Remove("c", 1))
+	assert.Equal(t, 3, m.Len())
+
+	// Removing the last value removes the key
+	assert.True(t, m.Remove("b", 3))
+	assert.False(t, m.Has("b"))
+	assert.Equal(t, 1, m.KeysLen())
+
+	// Delete all values
+	m.Add("b", 4)
+	assert.Equal(t, 2, m.Delete("a"))
+	assert.Equal(t, 0, m.Delete("a"))
+	assert.Equal(t, 1, m.Len())
+	assert.Equal(t, []int{4}, m.Get("b"))
+}
+
+func TestMultiMap_Iterate(t *testing.T) {
+	m := NewMultiMap[string, int](2)
+	m.Add("a", 1, 2)
+	m.Add("b", 3)
+
+	pairs := make(map[string][]int)
+	for k, v := range m.Iterate() {
+		pairs[k] = append(pairs[k], v)
+	}
+	assert.Equal(t, map[string][]int{"a": {1, 2}, "b": {3}}, pairs)
+
+	keys := make([]string, 0, m.KeysLen())
+	for k := range m.Keys() {
+		keys = append(keys, k)
+	}
+	assert.ElementsMatch(t, []string{"a", "b"}, keys)
+
+	// Break the loop
+	count := 0
+	for range m.Iterate() {
+		count++
+		break
+	}
+	assert.Equal(t, 1, count)
+}
@@ -4,20 +4,28 @@ import (
 	"iter"
 )
 
+// orderedMapNode is an element of the doubly linked list that keeps the insertion order of OrderedMap.
+type orderedMapNode[K comparable, V any] struct {
+	key   K
+	value V
+	prev  *orderedMapNode[K, V]
+	next  *orderedMapNode[K, V]
+}
+
 // OrderedMap represents a map whose elements are iterated over in the order they are inserted.
-// Deleting elements is slow.
+// Set, Delete and GetValue take constant time.
 // To use this object, it is absolutely necessary to create the object using the NewOrderedMap constructor.
 type OrderedMap[K comparable, V any] struct {
-	data  map[K]V
-	order []K
+	data map[K]*orderedMapNode[K, V]
+	head *orderedMapNode[K, V]
+	tail *orderedMapNode[K, V]
 }
 
 // NewOrderedMap allocates and initializes new object of type OrderedMap and returns a pointer to it.
 // It is absolutely necessary to create the OrderedMap object using this constructor.
 func NewOrderedMap[K comparable, V any](size int) *OrderedMap[K, V] {
 	m := OrderedMap[K, V]{
-		data:  make(map[K]V, size),
-		order: make([]K, 0, size),
+		data: make(map[K]*orderedMapNode[K, V], size),
 	}
 
 	return &m
@@ -44,12 +52,9 @@ func (m *OrderedMap[K, _]) Has(k K) bool {
 // GetValue returns a value by the given key.
 // If m is nil or k is not found, returns zero value of type V.
 func (m *OrderedMap[K, V]) GetValue(k K) V {
-	if m == nil {
-		var v V
-		return v
-	}
+	v, _ := m.GetAndCheck(k)
 
-	return m.data[k]
+	return v
 }
 
 // GetAndCheck like GetValue and Has methods,
@@ -60,46 +65,280 @@ func (m *OrderedMap[K, V]) GetAndCheck(k K) (V, bool) {
 		return v, false
 	}
 
-	v, ok := m.data[k]
+	n, ok := m.data[k]
+	if !ok {
+		var v V
+		return v, false
+	}
 
-	return v, ok
+	return n.value, true
 }
 
 // Set sets the given key-value pair into the map.
 // Panics if m is not initialized by NewOrderedMap constructor.
 func (m *OrderedMap[K, V]) Set(k K, v V) {
-	if !m.Has(k) {
-		m.order = append(m.order, k)
+	if n, ok := m.data[k]; ok {
+		n.value = v
+		return
 	}
-	m.data[k] = v
+
+	n := &orderedMapNode[K, V]{key: k, value: v}
+	m.data[k] = n
+	m.pushBack(n)
 }
 
 // Delete deletes an element by the given key if the key exists.
 func (m *OrderedMap[K, _]) Delete(k K) {
-	if !m.Has(k) {
+	if m == nil {
+		return
+	}
+
+	n, ok := m.data[k]
+	if !ok {
 		return
 	}
 
 	delete(m.data, k)
+	m.unlink(n)
+}
 
-	for i, found := range m.order {
-		if found == k {
-			m.order = append(m.order[:i], m.order[i+1:]...)
-			break
+// Iterate iterates over map elements in the order they are inserted.
+// Elements may be deleted during iteration, deleted elements that have not been reached yet are not produced.
+func (m *OrderedMap[K, V]) Iterate() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+		for n := m.head; n != nil; n = m.nextAlive(n) {
+			if !yield(n.key, n.value) {
+				return
+			}
 		}
 	}
 }
 
-// Iterate iterates over map elements in the order they are inserted.
-func (m *OrderedMap[K, V]) Iterate() iter.Seq2[K, V] {
+// Backward iterates over map elements in the reverse order.
+// Elements may be deleted during iteration, deleted elements that have not been reached yet are not produced.
+func (m *OrderedMap[K, V]) Backward() iter.Seq2[K, V] {
 	return func(yield func(K, V) bool) {
 		if m == nil {
 			return
 		}
-		for _, k := range m.order {
-			if !yield(k, m.data[k]) {
+		for n := m.tail; n != nil; n = m.prevAlive(n) {
+			if !yield(n.key, n.value) {
 				return
 			}
 		}
 	}
 }
+
+// Front returns the first element of the map.
+// If the map is empty, returns zero values and false.
+func (m *OrderedMap[K, V]) Front() (K, V, bool) {
+	if m == nil {
+		return nodeEntry[K, V](nil)
+	}
+
+	return nodeEntry(m.head)
+}
+
+// Back returns the last element of the map.
+// If the map is empty, returns zero values and false.
+func (m *OrderedMap[K, V]) Back() (K, V, bool) {
+	if m == nil {
+		return nodeEntry[K, V](nil)
+	}
+
+	return nodeEntry(m.tail)
+}
+
+// IndexOf returns the position of the given key in the map order or -1 if the key is not found.
+// Takes linear time.
+func (m *OrderedMap[K, _]) IndexOf(k K) int {
+	if !m.Has(k) {
+		return -1
+	}
+
+	i := 0
+	for n := m.head; n.key != k; n = n.next {
+		i++
+	}
+
+	return i
+}
+
+// MoveToFront moves the element by the given key to the beginning of the map.
+// Returns false if the key is not found.
+func (m *OrderedMap[K, _]) MoveToFront(k K) bool {
+	if m == nil {
+		return false
+	}
+
+	n, ok := m.data[k]
+	if !ok {
+		return false
+	}
+	if n != m.head {
+		m.unlink(n)
+		m.linkBefore(n, m.head)
+	}
+
+	return true
+}
+
+// MoveToBack moves the element by the given key to the end of the map.
+// Returns false if the key is not found.
+func (m *OrderedMap[K, _]) MoveToBack(k K) bool {
+	if m == nil {
+		return false
+	}
+
+	n, ok := m.data[k]
+	if !ok {
+		return false
+	}
+	if n != m.tail {
+		m.unlink(n)
+		m.pushBack(n)
+	}
+
+	return true
+}
+
+// InsertBefore sets the key-value pair into the map right before the element with the mark key.
+// If the key already exists, its value is updated and the element is moved.
+// Returns false and doesn't change the map if the mark key is not found.
+func (m *OrderedMap[K, V]) InsertBefore(mark, k K, v V) bool {
+	if m == nil {
+		return false
+	}
+
+	markNode, ok := m.data[mark]
+	if !ok {
+		return false
+	}
+
+	n := m.detachOrCreate(k, v, markNode)
+	if n != markNode {
+		m.linkBefore(n, markNode)
+	}
+
+	return true
+}
+
+// InsertAfter sets the key-value pair into the map right after the element with the mark key.
+// If the key already exists, its value is updated and the element is moved.
+// Returns false and doesn't change the map if the mark key is not found.
+func (m *OrderedMap[K, V]) InsertAfter(mark, k K, v V) bool {
+	if m == nil {
+		return false
+	}
+
+	markNode, ok := m.data[mark]
+	if !ok {
+		return false
+	}
+
+	n := m.detachOrCreate(k, v, markNode)
+	if n == markNode {
+		return true
+	}
+	if markNode.next != nil {
+		m.linkBefore(n, markNode.next)
+	} else {
+		m.pushBack(n)
+	}
+
+	return true
+}
+
+// detachOrCreate returns the node of the given key with the new value.
+// A new node is created if the key is not found, an existing node other than mark is unlinked from the list.
+func (m *OrderedMap[K, V]) detachOrCreate(k K, v V, mark *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	n, ok := m.data[k]
+	if !ok {
+		n = &orderedMapNode[K, V]{key: k, value: v}
+		m.data[k] = n
+
+		return n
+	}
+
+	n.value = v
+	if n != mark {
+		m.unlink(n)
+	}
+
+	return n
+}
+
+// nodeEntry returns the key and the value of the node, and false if the node is nil.
+func nodeEntry[K comparable, V any](n *orderedMapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			k K
+			v V
+		)
+		return k, v, false
+	}
+
+	return n.key, n.value, true
+}
+
+// pushBack appends the node to the end of the list.
+func (m *OrderedMap[K, V]) pushBack(n *orderedMapNode[K, V]) {
+	n.prev = m.tail
+	n.next = nil
+	if m.tail != nil {
+		m.tail.next = n
+	} else {
+		m.head = n
+	}
+	m.tail = n
+}
+
+// linkBefore inserts the node into the list right before the mark node.
+func (m *OrderedMap[K, V]) linkBefore(n, mark *orderedMapNode[K, V]) {
+	n.prev = mark.prev
+	n.next = mark
+	if mark.prev != nil {
+		mark.prev.next = n
+	} else {
+		m.head = n
+	}
+	mark.prev = n
+}
+
+// unlink removes the node from the list.
+// The links of the removed node are kept, so an iterator standing on it is able to move forward.
+func (m *OrderedMap[K, V]) unlink(n *orderedMapNode[K, V]) {
+	if n.prev != nil {
+		n.prev.next = n.next
+	} else {
+		m.head = n.next
+	}
+	if n.next != nil {
+		n.next.prev = n.prev
+	} else {
+		m.tail = n.prev
+	}
+}
+
+// nextAlive returns the node following n, skipping nodes removed from the map.
+func (m *OrderedMap[K, V]) nextAlive(n *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	next := n.next
+	for next != nil && m.data[next.key] != next {
+		next = next.next
+	}
+
+	return next
+}
+
+// prevAlive returns the node preceding n, skipping nodes removed from the map.
+func (m *OrderedMap[K, V]) prevAlive(n *orderedMapNode[K, V]) *orderedMapNode[K, V] {
+	prev := n.prev
+	for prev != nil && m.data[prev.key] != prev {
+		prev = prev.prev
+	}
+
+	return prev
+}
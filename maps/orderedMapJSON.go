@@ -0,0 +1,177 @@
+package maps
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+var (
+	_ json.Marshaler   = (*OrderedMap[string, any])(nil)
+	_ json.Unmarshaler = (*OrderedMap[string, any])(nil)
+)
+
+// MarshalJSON encodes the map as a JSON object with keys in the order they are inserted.
+// Keys are encoded like encoding/json encodes keys of native maps:
+// strings are used directly, encoding.TextMarshaler keys are marshaled and integer keys are formatted in base 10.
+func (m *OrderedMap[K, V]) MarshalJSON() ([]byte, error) {
+	if m == nil {
+		return []byte("null"), nil
+	}
+
+	buf := bytes.NewBuffer(make([]byte, 0, 2+m.Len()*16))
+	buf.WriteByte('{')
+
+	first := true
+	for k, v := range m.Iterate() {
+		name, err := marshalJSONKey(k)
+		if err != nil {
+			return nil, err
+		}
+		key, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+
+		if !first {
+			buf.WriteByte(',')
+		}
+		first = false
+
+		buf.Write(key)
+		buf.WriteByte(':')
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON object into the map, inserting elements in the document order.
+// Like encoding/json does for native maps, the existing elements are kept and null is a no-op.
+// The map doesn't have to be created by the NewOrderedMap constructor.
+func (m *OrderedMap[K, V]) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return &json.UnmarshalTypeError{Value: jsonValueKind(tok), Type: reflect.TypeOf(m)}
+	}
+
+	if m.data == nil {
+		m.data = make(map[K]*orderedMapNode[K, V])
+	}
+
+	for dec.More() {
+		tok, err = dec.Token()
+		if err != nil {
+			return err
+		}
+
+		name := tok.(string) // keys of a JSON object are always strings
+		k, err := unmarshalJSONKey[K](name)
+		if err != nil {
+			return err
+		}
+
+		var v V
+		if err = dec.Decode(&v); err != nil {
+			return err
+		}
+
+		m.Set(k, v)
+	}
+
+	// Consume the closing delimiter to make sure the object is complete.
+	_, err = dec.Token()
+
+	return err
+}
+
+// marshalJSONKey converts the key to a JSON object key.
+func marshalJSONKey[K comparable](k K) (string, error) {
+	rv := reflect.ValueOf(&k).Elem()
+	if rv.Kind() == reflect.String {
+		return rv.String(), nil
+	}
+
+	if tm, ok := any(k).(encoding.TextMarshaler); ok {
+		if rv.Kind() == reflect.Pointer && rv.IsNil() {
+			return "", nil
+		}
+		buf, err := tm.MarshalText()
+
+		return string(buf), err
+	}
+
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(rv.Uint(), 10), nil
+	}
+
+	return "", &json.UnsupportedTypeError{Type: rv.Type()}
+}
+
+// unmarshalJSONKey converts a JSON object key to the key of type K.
+func unmarshalJSONKey[K comparable](name string) (K, error) {
+	var k K
+
+	if tu, ok := any(&k).(encoding.TextUnmarshaler); ok {
+		err := tu.UnmarshalText([]byte(name))
+
+		return k, err
+	}
+
+	rv := reflect.ValueOf(&k).Elem()
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(name)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(name, 10, 64)
+		if err != nil || rv.OverflowInt(n) {
+			return k, &json.UnmarshalTypeError{Value: "number " + name, Type: rv.Type()}
+		}
+		rv.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(name, 10, 64)
+		if err != nil || rv.OverflowUint(n) {
+			return k, &json.UnmarshalTypeError{Value: "number " + name, Type: rv.Type()}
+		}
+		rv.SetUint(n)
+	default:
+		return k, &json.UnmarshalTypeError{Value: "object", Type: rv.Type()}
+	}
+
+	return k, nil
+}
+
+// jsonValueKind returns the name of the JSON value kind of the token for error messages.
+func jsonValueKind(tok json.Token) string {
+	switch tok.(type) {
+	case json.Delim:
+		return "array"
+	case bool:
+		return "bool"
+	case float64, json.Number:
+		return "number"
+	case string:
+		return "string"
+	}
+
+	return fmt.Sprintf("%T", tok)
+}
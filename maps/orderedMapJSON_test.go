@@ -0,0 +1,166 @@
+package maps
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type jsonTextKey struct {
+	a, b int
+}
+
+func (k jsonTextKey) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("%d-%d", k.a, k.b)), nil
+}
+
+func (k *jsonTextKey) UnmarshalText(text []byte) error {
+	_, err := fmt.Sscanf(string(text), "%d-%d", &k.a, &k.b)
+
+	return err
+}
+
+func TestOrderedMap_MarshalJSON(t *testing.T) {
+	// Map is nil
+	var m *OrderedMap[string, int]
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `null`, string(data))
+
+	// Map is not initialized by constructor
+	m = &OrderedMap[string, int]{}
+	data, err = json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `{}`, string(data))
+
+	// String keys are encoded in the insertion order
+	m = NewOrderedMap[string, int](3)
+	m.Set("name", 1)
+	m.Set("price", 2)
+	m.Set("amount", 3)
+	m.Set("<b>", 4)
+	data, err = json.Marshal(m)
+	require.NoError(t, err)
+	assert.Equal(t, `{"name":1,"price":2,"amount":3,"\u003cb\u003e":4}`, string(data))
+
+	// Integer keys
+	ints := NewOrderedMap[int8, string](2)
+	ints.Set(10, "ten")
+	ints.Set(-2, "minus two")
+	data, err = json.Marshal(ints)
+	require.NoError(t, err)
+	assert.Equal(t, `{"10":"ten","-2":"minus two"}`, string(data))
+
+	// encoding.TextMarshaler keys
+	texts := NewOrderedMap[jsonTextKey, bool](2)
+	texts.Set(jsonTextKey{2, 3}, true)
+	texts.Set(jsonTextKey{1, 0}, false)
+	data, err = json.Marshal(texts)
+	require.NoError(t, err)
+	assert.Equal(t, `{"2-3":true,"1-0":false}`, string(data))
+
+	// Nested in a struct and indented
+	type response struct {
+		Columns *OrderedMap[string, []int] `json:"columns"`
+	}
+	columns := NewOrderedMap[string, []int](2)
+	columns.Set("b", []int{1})
+	columns.Set("a", nil)
+	data, err = json.MarshalIndent(response{Columns: columns}, "", "  ")
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"columns\": {\n    \"b\": [\n      1\n    ],\n    \"a\": null\n  }\n}", string(data))
+
+	// Unsupported key type
+	floats := NewOrderedMap[float64, int](1)
+	floats.Set(1.5, 1)
+	_, err = json.Marshal(floats)
+	assert.Error(t, err)
+
+	// Value can't be encoded
+	funcs := NewOrderedMap[string, func()](1)
+	funcs.Set("f", func() {})
+	_, err = json.Marshal(funcs)
+	assert.Error(t, err)
+}
+
+func TestOrderedMap_UnmarshalJSON(t *testing.T) {
+	// Map is not initialized by constructor
+	m := &OrderedMap[string, int]{}
+	require.NoError(t, json.Unmarshal([]byte(`{"z": 1, "a": 2, "m": 3}`), m))
+	assert.Equal(t, []string{"z", "a", "m"}, orderedMapKeys(m))
+	assert.Equal(t, 2, m.GetValue("a"))
+
+	// Existing elements are kept, duplicated keys keep the first position
+	require.NoError(t, json.Unmarshal([]byte(`{"b": 4, "z": 5}`), m))
+	assert.Equal(t, []string{"z", "a", "m", "b"}, orderedMapKeys(m))
+	assert.Equal(t, 5, m.GetValue("z"))
+
+	// Null is a no-op
+	require.NoError(t, json.Unmarshal([]byte(`null`), m))
+	assert.Equal(t, 4, m.Len())
+
+	// Integer keys
+	ints := NewOrderedMap[int16, string](0)
+	require.NoError(t, json.Unmarshal([]byte(`{"10": "ten", "-2": "minus two"}`), ints))
+	assert.Equal(t, []int16{10, -2}, orderedMapKeys(ints))
+
+	// Integer key overflow
+	bytesMap := NewOrderedMap[uint8, string](0)
+	var typeErr *json.UnmarshalTypeError
+	err := json.Unmarshal([]byte(`{"300": "overflow"}`), bytesMap)
+	require.ErrorAs(t, err, &typeErr)
+	assert.Equal(t, "number 300", typeErr.Value)
+
+	// encoding.TextUnmarshaler keys
+	texts := NewOrderedMap[jsonTextKey, bool](0)
+	require.NoError(t, json.Unmarshal([]byte(`{"2-3": true, "1-0": false}`), texts))
+	assert.Equal(t, []jsonTextKey{{2, 3}, {1, 0}}, orderedMapKeys(texts))
+
+	// Nested in a struct as a value
+	type request struct {
+		Columns OrderedMap[string, *OrderedMap[string, int]] `json:"columns"`
+	}
+	var r request
+	require.NoError(t, json.Unmarshal([]byte(`{"columns": {"b": {"y": 1, "x": 2}, "a": null}}`), &r))
+	assert.Equal(t, []string{"b", "a"}, orderedMapKeys(&r.Columns))
+	assert.Equal(t, []string{"y", "x"}, orderedMapKeys(r.Columns.GetValue("b")))
+	assert.Nil(t, r.Columns.GetValue("a"))
+
+	// Not an object
+	err = json.Unmarshal([]byte(`[1, 2]`), m)
+	require.ErrorAs(t, err, &typeErr)
+	assert.Equal(t, "array", typeErr.Value)
+
+	// Value of the wrong type
+	err = json.Unmarshal([]byte(`{"a": "string"}`), m)
+	assert.Error(t, err)
+
+	// Unsupported key type
+	floats := NewOrderedMap[float64, int](0)
+	assert.Error(t, json.Unmarshal([]byte(`{"1.5": 1}`), floats))
+
+	// Round trip keeps the order
+	keys := strings.Split("q w e r t y u i o p", " ")
+	m = NewOrderedMap[string, int](len(keys))
+	for i, k := range keys {
+		m.Set(k, i)
+	}
+	data, err := json.Marshal(m)
+	require.NoError(t, err)
+	decoded := &OrderedMap[string, int]{}
+	require.NoError(t, json.Unmarshal(data, decoded))
+	assert.Equal(t, keys, orderedMapKeys(decoded))
+}
+
+func orderedMapKeys[K comparable, V any](m *OrderedMap[K, V]) []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.Iterate() {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
@@ -182,6 +182,263 @@ func TestOrderedMap_Iterate(t *testing.T) {
 	assert.Equal(t, digits, result)
 }
 
+func TestOrderedMap_DeleteKeepsOrder(t *testing.T) {
+	m := NewOrderedMap[int, string](5)
+	for i := 0; i < 5; i++ {
+		m.Set(i, fmt.Sprint(i))
+	}
+
+	// Delete head, tail and an element from the middle
+	m.Delete(0)
+	m.Delete(4)
+	m.Delete(2)
+
+	// Re-inserted key is placed at the end
+	m.Set(0, "zero")
+
+	// Updating an existing key keeps its position
+	m.Set(1, "one")
+
+	keys := make([]int, 0, m.Len())
+	values := make([]string, 0, m.Len())
+	for k, v := range m.Iterate() {
+		keys = append(keys, k)
+		values = append(values, v)
+	}
+	assert.Equal(t, []int{1, 3, 0}, keys)
+	assert.Equal(t, []string{"one", "3", "zero"}, values)
+
+	// Delete all elements
+	for _, k := range keys {
+		m.Delete(k)
+	}
+	assert.Equal(t, 0, m.Len())
+	for k, v := range m.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over empty object, key=%d, value='%s'", k, v))
+	}
+}
+
+func TestOrderedMap_DeleteWhileIterating(t *testing.T) {
+	m := NewOrderedMap[int, int](6)
+	for i := 0; i < 6; i++ {
+		m.Set(i, i)
+	}
+
+	// Delete the current and the next element on each step
+	keys := make([]int, 0, m.Len())
+	for k := range m.Iterate() {
+		keys = append(keys, k)
+		m.Delete(k)
+		m.Delete(k + 1)
+	}
+	assert.Equal(t, []int{0, 2, 4}, keys)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestOrderedMap_Backward(t *testing.T) {
+	// Map nil object
+	var m *OrderedMap[int, int]
+	for k, v := range m.Backward() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%d'", k, v))
+	}
+
+	// Map is not initialized by constructor
+	m = &OrderedMap[int, int]{}
+	for k, v := range m.Backward() {
+		require.Fail(t, fmt.Sprintf("iterate over empty object, key=%d, value='%d'", k, v))
+	}
+
+	m = NewOrderedMap[int, int](5)
+	for i := 0; i < 5; i++ {
+		m.Set(i, i*10)
+	}
+	m.Delete(2)
+	assert.Equal(t, []int{4, 3, 1, 0}, orderedMapBackwardKeys(m))
+
+	// Delete the current and the previous element on each step
+	keys := make([]int, 0, m.Len())
+	for k := range m.Backward() {
+		keys = append(keys, k)
+		m.Delete(k)
+		m.Delete(k - 1)
+	}
+	assert.Equal(t, []int{4, 1}, keys)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestOrderedMap_FrontBack(t *testing.T) {
+	// Map is nil
+	var m *OrderedMap[string, int]
+	k, v, ok := m.Front()
+	assert.True(t, k == "" && v == 0 && !ok)
+	k, v, ok = m.Back()
+	assert.True(t, k == "" && v == 0 && !ok)
+
+	// Map is empty
+	m = NewOrderedMap[string, int](2)
+	_, _, ok = m.Front()
+	assert.False(t, ok)
+
+	m.Set("a", 1)
+	m.Set("b", 2)
+	k, v, ok = m.Front()
+	assert.True(t, k == "a" && v == 1 && ok)
+	k, v, ok = m.Back()
+	assert.True(t, k == "b" && v == 2 && ok)
+
+	// After deleting the only element
+	m.Delete("a")
+	m.Delete("b")
+	_, _, ok = m.Back()
+	assert.False(t, ok)
+}
+
+func TestOrderedMap_IndexOf(t *testing.T) {
+	var m *OrderedMap[string, int]
+	assert.Equal(t, -1, m.IndexOf("a"))
+
+	m = NewOrderedMap[string, int](3)
+	m.Set("a", 1)
+	m.Set("b", 2)
+	m.Set("c", 3)
+	assert.Equal(t, 0, m.IndexOf("a"))
+	assert.Equal(t, 2, m.IndexOf("c"))
+	assert.Equal(t, -1, m.IndexOf("d"))
+
+	m.Delete("a")
+	assert.Equal(t, 1, m.IndexOf("c"))
+}
+
+func TestOrderedMap_Move(t *testing.T) {
+	// Map is nil
+	var m *OrderedMap[string, int]
+	assert.False(t, m.MoveToFront("a"))
+	assert.False(t, m.MoveToBack("a"))
+
+	m = NewOrderedMap[string, int](4)
+	for i, k := range []string{"a", "b", "c", "d"} {
+		m.Set(k, i)
+	}
+
+	// Non-existent key
+	assert.False(t, m.MoveToFront("e"))
+	assert.False(t, m.MoveToBack("e"))
+	assertOrderedMapKeys(t, m, "a", "b", "c", "d")
+
+	assert.True(t, m.MoveToFront("c"))
+	assertOrderedMapKeys(t, m, "c", "a", "b", "d")
+
+	assert.True(t, m.MoveToFront("c"))
+	assertOrderedMapKeys(t, m, "c", "a", "b", "d")
+
+	assert.True(t, m.MoveToBack("c"))
+	assertOrderedMapKeys(t, m, "a", "b", "d", "c")
+
+	assert.True(t, m.MoveToBack("c"))
+	assertOrderedMapKeys(t, m, "a", "b", "d", "c")
+
+	assert.True(t, m.MoveToFront("c"))
+	assert.True(t, m.MoveToBack("a"))
+	assertOrderedMapKeys(t, m, "c", "b", "d", "a")
+
+	// Values are kept
+	assert.Equal(t, 2, m.GetValue("c"))
+	assert.Equal(t, 0, m.GetValue("a"))
+}
+
+func TestOrderedMap_InsertBefore(t *testing.T) {
+	// Map is nil
+	var m *OrderedMap[string, int]
+	assert.False(t, m.InsertBefore("a", "b", 1))
+
+	m = NewOrderedMap[string, int](4)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	// Non-existent mark doesn't change the map
+	assert.False(t, m.InsertBefore("z", "c", 3))
+	assert.False(t, m.Has("c"))
+
+	// New key before the first element
+	assert.True(t, m.InsertBefore("a", "c", 3))
+	assertOrderedMapKeys(t, m, "c", "a", "b")
+
+	// New key in the middle
+	assert.True(t, m.InsertBefore("b", "d", 4))
+	assertOrderedMapKeys(t, m, "c", "a", "d", "b")
+
+	// Existing key is moved and updated
+	assert.True(t, m.InsertBefore("c", "b", 20))
+	assertOrderedMapKeys(t, m, "b", "c", "a", "d")
+	assert.Equal(t, 20, m.GetValue("b"))
+
+	// Key equal to the mark is updated in place
+	assert.True(t, m.InsertBefore("a", "a", 10))
+	assertOrderedMapKeys(t, m, "b", "c", "a", "d")
+	assert.Equal(t, 10, m.GetValue("a"))
+	assert.Equal(t, 4, m.Len())
+}
+
+func TestOrderedMap_InsertAfter(t *testing.T) {
+	// Map is nil
+	var m *OrderedMap[string, int]
+	assert.False(t, m.InsertAfter("a", "b", 1))
+
+	m = NewOrderedMap[string, int](4)
+	m.Set("a", 1)
+	m.Set("b", 2)
+
+	// Non-existent mark doesn't change the map
+	assert.False(t, m.InsertAfter("z", "c", 3))
+	assert.False(t, m.Has("c"))
+
+	// New key after the last element
+	assert.True(t, m.InsertAfter("b", "c", 3))
+	assertOrderedMapKeys(t, m, "a", "b", "c")
+
+	// New key in the middle
+	assert.True(t, m.InsertAfter("a", "d", 4))
+	assertOrderedMapKeys(t, m, "a", "d", "b", "c")
+
+	// Existing key is moved and updated
+	assert.True(t, m.InsertAfter("c", "a", 10))
+	assertOrderedMapKeys(t, m, "d", "b", "c", "a")
+	assert.Equal(t, 10, m.GetValue("a"))
+
+	// Existing key is moved after its next element
+	assert.True(t, m.InsertAfter("b", "d", 40))
+	assertOrderedMapKeys(t, m, "b", "d", "c", "a")
+
+	// Key equal to the mark is updated in place
+	assert.True(t, m.InsertAfter("a", "a", 100))
+	assertOrderedMapKeys(t, m, "b", "d", "c", "a")
+	assert.Equal(t, 100, m.GetValue("a"))
+	assert.Equal(t, 4, m.Len())
+}
+
+// assertOrderedMapKeys checks the order of keys in both directions.
+func assertOrderedMapKeys[K comparable, V any](t *testing.T, m *OrderedMap[K, V], keys ...K) {
+	t.Helper()
+
+	assert.Equal(t, keys, orderedMapKeys(m))
+
+	backward := orderedMapBackwardKeys(m)
+	for i, j := 0, len(backward)-1; i < j; i, j = i+1, j-1 {
+		backward[i], backward[j] = backward[j], backward[i]
+	}
+	assert.Equal(t, keys, backward)
+	assert.Equal(t, len(keys), m.Len())
+}
+
+func orderedMapBackwardKeys[K comparable, V any](m *OrderedMap[K, V]) []K {
+	keys := make([]K, 0, m.Len())
+	for k := range m.Backward() {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
 func BenchmarkOrderedMap(b *testing.B) {
 	size := 1000
 	m := NewOrderedMap[int, int](size)
@@ -196,3 +453,106 @@ func BenchmarkOrderedMap(b *testing.B) {
 		}
 	}
 }
+
+// sliceOrderedMap is the previous layout of OrderedMap, which keeps the order in a slice.
+// It is used only to compare the performance of layouts.
+type sliceOrderedMap[K comparable, V any] struct {
+	data  map[K]V
+	order []K
+}
+
+func (m *sliceOrderedMap[K, V]) Set(k K, v V) {
+	if _, ok := m.data[k]; !ok {
+		m.order = append(m.order, k)
+	}
+	m.data[k] = v
+}
+
+func (m *sliceOrderedMap[K, V]) GetValue(k K) V {
+	return m.data[k]
+}
+
+func (m *sliceOrderedMap[K, _]) Delete(k K) {
+	if _, ok := m.data[k]; !ok {
+		return
+	}
+
+	delete(m.data, k)
+
+	for i, found := range m.order {
+		if found == k {
+			m.order = append(m.order[:i], m.order[i+1:]...)
+			break
+		}
+	}
+}
+
+type benchOrderedMap interface {
+	Set(k, v int)
+	GetValue(k int) int
+	Delete(k int)
+}
+
+func benchOrderedMapLayouts(b *testing.B, run func(b *testing.B, newMap func(size int) benchOrderedMap, size int)) {
+	layouts := []struct {
+		name   string
+		newMap func(size int) benchOrderedMap
+	}{
+		{"LinkedList", func(size int) benchOrderedMap { return NewOrderedMap[int, int](size) }},
+		{"Slice", func(size int) benchOrderedMap {
+			return &sliceOrderedMap[int, int]{data: make(map[int]int, size), order: make([]int, 0, size)}
+		}},
+	}
+
+	for _, size := range []int{100, 10_000} {
+		for _, layout := range layouts {
+			b.Run(fmt.Sprintf("%s/%d", layout.name, size), func(b *testing.B) {
+				run(b, layout.newMap, size)
+			})
+		}
+	}
+}
+
+func BenchmarkOrderedMap_Set(b *testing.B) {
+	benchOrderedMapLayouts(b, func(b *testing.B, newMap func(size int) benchOrderedMap, size int) {
+		for i := 0; i < b.N; i++ {
+			m := newMap(size)
+			for j := 0; j < size; j++ {
+				m.Set(j, j)
+			}
+		}
+	})
+}
+
+func BenchmarkOrderedMap_GetValue(b *testing.B) {
+	benchOrderedMapLayouts(b, func(b *testing.B, newMap func(size int) benchOrderedMap, size int) {
+		m := newMap(size)
+		for j := 0; j < size; j++ {
+			m.Set(j, j)
+		}
+
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			m.GetValue(i % size)
+		}
+	})
+}
+
+func BenchmarkOrderedMap_Delete(b *testing.B) {
+	benchOrderedMapLayouts(b, func(b *testing.B, newMap func(size int) benchOrderedMap, size int) {
+		for i := 0; i < b.N; i++ {
+			b.StopTimer()
+			m := newMap(size)
+			for j := 0; j < size; j++ {
+				m.Set(j, j)
+			}
+			b.StartTimer()
+
+			// Delete from the middle to the edges, so the slice layout has to scan and shift
+			for j := 0; j < size/2; j++ {
+				m.Delete(size/2 + j)
+				m.Delete(size/2 - j - 1)
+			}
+		}
+	})
+}
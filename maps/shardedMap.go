@@ -0,0 +1,201 @@
+package maps
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"runtime"
+	"sync"
+)
+
+// shardedMapShard is a part of ShardedMap guarded by its own lock.
+type shardedMapShard[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data map[K]V
+	_    [64]byte // padding to keep locks of neighbouring shards in different cache lines
+}
+
+// ShardedMap is a map that is safe for concurrent use by multiple goroutines.
+// Keys are distributed between shards by a hash function, every shard has its own lock,
+// so operations on keys from different shards don't contend with each other.
+// To use this object, it is absolutely necessary to create the object using the NewShardedMap constructor.
+type ShardedMap[K comparable, V any] struct {
+	shards []shardedMapShard[K, V]
+	mask   uint64
+	hash   func(K) uint64
+}
+
+// NewShardedMap allocates and initializes new object of type ShardedMap and returns a pointer to it.
+// The number of shards is rounded up to a power of two, shards <= 0 means 4 shards per available CPU.
+// If hash is nil, maphash.Comparable with a random seed is used.
+// It is absolutely necessary to create the ShardedMap object using this constructor.
+func NewShardedMap[K comparable, V any](shards int, hash func(K) uint64) *ShardedMap[K, V] {
+	if shards <= 0 {
+		shards = runtime.GOMAXPROCS(0) * 4
+	}
+	shards = 1 << bits.Len(uint(shards-1))
+
+	if hash == nil {
+		seed := maphash.MakeSeed()
+		hash = func(k K) uint64 {
+			return maphash.Comparable(seed, k)
+		}
+	}
+
+	m := ShardedMap[K, V]{
+		shards: make([]shardedMapShard[K, V], shards),
+		mask:   uint64(shards - 1),
+		hash:   hash,
+	}
+	for i := range m.shards {
+		m.shards[i].data = make(map[K]V)
+	}
+
+	return &m
+}
+
+// Len returns the length of map.
+// Shards are counted one by one, so the result may be inaccurate while the map is being modified.
+func (m *ShardedMap[_, _]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	n := 0
+	for i := range m.shards {
+		s := &m.shards[i]
+		s.mu.RLock()
+		n += len(s.data)
+		s.mu.RUnlock()
+	}
+
+	return n
+}
+
+// Load returns a value by the given key and checks if the map contains the key.
+func (m *ShardedMap[K, V]) Load(k K) (V, bool) {
+	if m == nil || len(m.shards) == 0 {
+		var v V
+		return v, false
+	}
+
+	s := m.shard(k)
+	s.mu.RLock()
+	v, ok := s.data[k]
+	s.mu.RUnlock()
+
+	return v, ok
+}
+
+// Store sets the given key-value pair into the map.
+// Panics if m is not initialized by NewShardedMap constructor.
+func (m *ShardedMap[K, V]) Store(k K, v V) {
+	s := m.shard(k)
+	s.mu.Lock()
+	s.data[k] = v
+	s.mu.Unlock()
+}
+
+// LoadOrStore returns the existing value for the key if present.
+// Otherwise, it stores the given value and returns it.
+// The loaded result is true if the value was loaded, false if stored.
+// Panics if m is not initialized by NewShardedMap constructor.
+func (m *ShardedMap[K, V]) LoadOrStore(k K, v V) (actual V, loaded bool) {
+	s := m.shard(k)
+
+	s.mu.RLock()
+	actual, loaded = s.data[k]
+	s.mu.RUnlock()
+	if loaded {
+		return actual, true
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if actual, loaded = s.data[k]; loaded {
+		return actual, true
+	}
+	s.data[k] = v
+
+	return v, false
+}
+
+// LoadAndDelete deletes an element by the given key, returning the previous value if any.
+func (m *ShardedMap[K, V]) LoadAndDelete(k K) (V, bool) {
+	if m == nil || len(m.shards) == 0 {
+		var v V
+		return v, false
+	}
+
+	s := m.shard(k)
+	s.mu.Lock()
+	v, ok := s.data[k]
+	delete(s.data, k)
+	s.mu.Unlock()
+
+	return v, ok
+}
+
+// Delete deletes an element by the given key if the key exists.
+func (m *ShardedMap[K, _]) Delete(k K) {
+	m.LoadAndDelete(k)
+}
+
+// Compute atomically replaces the value by the given key with the result of fn.
+// fn receives the current value and whether the key exists, and returns the new value and whether to keep it;
+// if keep is false, the element is deleted. Returns the new value and whether the element is present.
+// fn is called under the shard lock, so it must not access the map.
+// Panics if m is not initialized by NewShardedMap constructor.
+func (m *ShardedMap[K, V]) Compute(k K, fn func(v V, ok bool) (newV V, keep bool)) (V, bool) {
+	s := m.shard(k)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	old, ok := s.data[k]
+	v, keep := fn(old, ok)
+	if !keep {
+		delete(s.data, k)
+
+		var zero V
+		return zero, false
+	}
+	s.data[k] = v
+
+	return v, true
+}
+
+// Range calls f sequentially for each element of the map. If f returns false, Range stops the iteration.
+// Elements of every shard are copied under the lock, so f may modify the map;
+// changes made during the iteration may be not visible to it. The order is not specified.
+func (m *ShardedMap[K, V]) Range(f func(k K, v V) bool) {
+	if m == nil {
+		return
+	}
+
+	var (
+		keys   []K
+		values []V
+	)
+	for i := range m.shards {
+		s := &m.shards[i]
+
+		s.mu.RLock()
+		keys, values = keys[:0], values[:0]
+		for k, v := range s.data {
+			keys = append(keys, k)
+			values = append(values, v)
+		}
+		s.mu.RUnlock()
+
+		for j := range keys {
+			if !f(keys[j], values[j]) {
+				return
+			}
+		}
+	}
+}
+
+// shard returns the shard of the given key.
+func (m *ShardedMap[K, V]) shard(k K) *shardedMapShard[K, V] {
+	return &m.shards[m.hash(k)&m.mask]
+}
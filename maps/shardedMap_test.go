@@ -0,0 +1,254 @@
+package maps
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestShardedMap_NotInitialized(t *testing.T) {
+	// Map is nil
+	var m *ShardedMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	v, ok := m.Load(1)
+	assert.True(t, v == "" && !ok)
+	v, ok = m.LoadAndDelete(1)
+	assert.True(t, v == "" && !ok)
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Store(1, "1") })
+	m.Range(func(k int, v string) bool {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%s'", k, v))
+		return true
+	})
+
+	// Map is initialized without constructor
+	m = &ShardedMap[int, string]{}
+	assert.Equal(t, 0, m.Len())
+	_, ok = m.Load(1)
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Store(1, "1") })
+}
+
+func TestNewShardedMap(t *testing.T) {
+	assert.Len(t, NewShardedMap[int, int](1, nil).shards, 1)
+	assert.Len(t, NewShardedMap[int, int](5, nil).shards, 8)
+	assert.Len(t, NewShardedMap[int, int](16, nil).shards, 16)
+	assert.GreaterOrEqual(t, len(NewShardedMap[int, int](0, nil).shards), 4)
+
+	// Custom hash function
+	m := NewShardedMap[int, int](4, func(k int) uint64 { return uint64(k) })
+	for i := 0; i < 8; i++ {
+		m.Store(i, i)
+	}
+	for i := range m.shards {
+		assert.Len(t, m.shards[i].data, 2)
+	}
+}
+
+func TestShardedMap(t *testing.T) {
+	m := NewShardedMap[string, int](4, nil)
+	m.Store("a", 1)
+	m.Store("b", 2)
+	m.Store("a", 3)
+
+	assert.Equal(t, 2, m.Len())
+	v, ok := m.Load("a")
+	assert.True(t, v == 3 && ok)
+	_, ok = m.Load("c")
+	assert.False(t, ok)
+
+	// LoadOrStore
+	v, loaded := m.LoadOrStore("a", 10)
+	assert.True(t, v == 3 && loaded)
+	v, loaded = m.LoadOrStore("c", 10)
+	assert.True(t, v == 10 && !loaded)
+
+	// LoadAndDelete and Delete
+	v, ok = m.LoadAndDelete("c")
+	assert.True(t, v == 10 && ok)
+	_, ok = m.LoadAndDelete("c")
+	assert.False(t, ok)
+	m.Delete("b")
+	assert.Equal(t, 1, m.Len())
+}
+
+func TestShardedMap_Compute(t *testing.T) {
+	m := NewShardedMap[string, int](4, nil)
+
+	// Absent key
+	v, ok := m.Compute("a", func(v int, ok bool) (int, bool) {
+		assert.False(t, ok)
+		return v + 1, true
+	})
+	assert.True(t, v == 1 && ok)
+
+	// Existing key
+	v, ok = m.Compute("a", func(v int, ok bool) (int, bool) {
+		assert.True(t, ok)
+		return v + 1, true
+	})
+	assert.True(t, v == 2 && ok)
+
+	// Delete
+	v, ok = m.Compute("a", func(v int, ok bool) (int, bool) {
+		return 0, false
+	})
+	assert.True(t, v == 0 && !ok)
+	_, ok = m.Load("a")
+	assert.False(t, ok)
+
+	// Absent key that is not kept
+	m.Compute("b", func(v int, ok bool) (int, bool) { return 5, false })
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestShardedMap_Range(t *testing.T) {
+	m := NewShardedMap[int, int](4, nil)
+	for i := 0; i < 100; i++ {
+		m.Store(i, i*10)
+	}
+
+	// Map may be modified during iteration
+	seen := make(map[int]int)
+	m.Range(func(k, v int) bool {
+		seen[k] = v
+		m.Delete(k)
+		return true
+	})
+	assert.Len(t, seen, 100)
+	assert.Equal(t, 990, seen[99])
+	assert.Equal(t, 0, m.Len())
+
+	// Stop the iteration
+	for i := 0; i < 100; i++ {
+		m.Store(i, i)
+	}
+	count := 0
+	m.Range(func(k, v int) bool {
+		count++
+		return count < 10
+	})
+	assert.Equal(t, 10, count)
+}
+
+func TestShardedMap_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 100
+	)
+
+	m := NewShardedMap[int, int](8, nil)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				m.Compute(i, func(v int, _ bool) (int, bool) { return v + 1, true })
+				m.LoadOrStore(i+keys, w)
+				m.Store(i+2*keys, w)
+				m.Delete(i + 2*keys)
+				m.Load(i)
+				m.Len()
+				m.Range(func(k, v int) bool { return false })
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for i := 0; i < keys; i++ {
+		v, _ := m.Load(i)
+		assert.Equal(t, workers, v)
+	}
+	assert.LessOrEqual(t, m.Len(), 3*keys)
+}
+
+// mutexMap is a map guarded by a single lock, used to compare the performance with ShardedMap.
+type mutexMap[K comparable, V any] struct {
+	mu   sync.RWMutex
+	data map[K]V
+}
+
+func (m *mutexMap[K, V]) Load(k K) (V, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	v, ok := m.data[k]
+
+	return v, ok
+}
+
+func (m *mutexMap[K, V]) Store(k K, v V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.data[k] = v
+}
+
+// syncMap is a typed wrapper of sync.Map, used to compare the performance with ShardedMap.
+type syncMap[K comparable, V any] struct {
+	m sync.Map
+}
+
+func (m *syncMap[K, V]) Load(k K) (V, bool) {
+	v, ok := m.m.Load(k)
+	if !ok {
+		var zero V
+		return zero, false
+	}
+
+	return v.(V), true
+}
+
+func (m *syncMap[K, V]) Store(k K, v V) {
+	m.m.Store(k, v)
+}
+
+type benchConcurrentMap interface {
+	Load(k int) (int, bool)
+	Store(k, v int)
+}
+
+func BenchmarkShardedMap(b *testing.B) {
+	const size = 10_000
+
+	implementations := []struct {
+		name   string
+		newMap func() benchConcurrentMap
+	}{
+		{"ShardedMap", func() benchConcurrentMap { return NewShardedMap[int, int](0, nil) }},
+		{"SyncMap", func() benchConcurrentMap { return &syncMap[int, int]{} }},
+		{"MutexMap", func() benchConcurrentMap { return &mutexMap[int, int]{data: make(map[int]int)} }},
+	}
+
+	// Percentage of writes in the workload
+	for _, writes := range []int{1, 10, 50} {
+		for _, impl := range implementations {
+			b.Run(fmt.Sprintf("%s/writes=%d%%", impl.name, writes), func(b *testing.B) {
+				m := impl.newMap()
+				for i := 0; i < size; i++ {
+					m.Store(i, i)
+				}
+
+				b.ResetTimer()
+				b.RunParallel(func(pb *testing.PB) {
+					i := 0
+					for pb.Next() {
+						k := (i * 7919) % size
+						if i%100 < writes {
+							m.Store(k, i)
+						} else {
+							m.Load(k)
+						}
+						i++
+					}
+				})
+			})
+		}
+	}
+}
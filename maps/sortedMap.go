@@ -0,0 +1,286 @@
+package maps
+
+import (
+	"cmp"
+	"iter"
+	"math/bits"
+	"math/rand/v2"
+)
+
+// sortedMapMaxLevel is enough for 2^32 elements with the level probability of 1/2.
+const sortedMapMaxLevel = 32
+
+// sortedMapNode is an element of the skip list of SortedMap.
+type sortedMapNode[K, V any] struct {
+	key     K
+	value   V
+	prev    *sortedMapNode[K, V]   // previous node on the lowest level, nil for the first node
+	next    []*sortedMapNode[K, V] // next nodes on every level of the node
+	removed bool
+}
+
+// SortedMap represents a map whose elements are iterated over in the order of keys.
+// It is backed by a skip list, so Set, Delete, GetValue, Floor and Ceiling take logarithmic time.
+// To use this object, it is absolutely necessary to create the object using
+// the NewSortedMap or NewSortedMapFunc constructor.
+type SortedMap[K, V any] struct {
+	cmp    func(a, b K) int
+	head   *sortedMapNode[K, V] // sentinel node, which doesn't contain an element
+	tail   *sortedMapNode[K, V]
+	level  int
+	length int
+}
+
+// NewSortedMap allocates and initializes new object of type SortedMap with keys in ascending order
+// and returns a pointer to it.
+// It is absolutely necessary to create the SortedMap object using this or the NewSortedMapFunc constructor.
+func NewSortedMap[K cmp.Ordered, V any]() *SortedMap[K, V] {
+	return NewSortedMapFunc[K, V](cmp.Compare[K])
+}
+
+// NewSortedMapFunc like NewSortedMap, allocates and initializes new object of type SortedMap,
+// which orders keys using the given comparison function.
+// The function must return a negative number when a < b, a positive number when a > b and zero when a == b.
+// Keys that are equal according to the function are considered to be the same key.
+func NewSortedMapFunc[K, V any](cmp func(a, b K) int) *SortedMap[K, V] {
+	m := SortedMap[K, V]{
+		cmp:   cmp,
+		head:  &sortedMapNode[K, V]{next: make([]*sortedMapNode[K, V], sortedMapMaxLevel)},
+		level: 1,
+	}
+
+	return &m
+}
+
+// Len returns the length of map.
+func (m *SortedMap[_, _]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	return m.length
+}
+
+// Has checks if the map contains the given key.
+func (m *SortedMap[K, _]) Has(k K) bool {
+	_, ok := m.GetAndCheck(k)
+
+	return ok
+}
+
+// GetValue returns a value by the given key.
+// If m is nil or k is not found, returns zero value of type V.
+func (m *SortedMap[K, V]) GetValue(k K) V {
+	v, _ := m.GetAndCheck(k)
+
+	return v
+}
+
+// GetAndCheck like GetValue and Has methods,
+// returns a value by the given key and checks if the map contains the key.
+func (m *SortedMap[K, V]) GetAndCheck(k K) (V, bool) {
+	n := m.ceilingNode(k)
+	if n == nil || m.cmp(n.key, k) != 0 {
+		var v V
+		return v, false
+	}
+
+	return n.value, true
+}
+
+// Set sets the given key-value pair into the map.
+// Panics if m is not initialized by a constructor.
+func (m *SortedMap[K, V]) Set(k K, v V) {
+	var update [sortedMapMaxLevel]*sortedMapNode[K, V]
+	if n := m.findPredecessors(k, &update).next[0]; n != nil && m.cmp(n.key, k) == 0 {
+		n.value = v
+		return
+	}
+
+	level := randomSortedMapLevel()
+	for i := m.level; i < level; i++ {
+		update[i] = m.head
+	}
+	m.level = max(m.level, level)
+
+	n := &sortedMapNode[K, V]{key: k, value: v, next: make([]*sortedMapNode[K, V], level)}
+	for i := 0; i < level; i++ {
+		n.next[i] = update[i].next[i]
+		update[i].next[i] = n
+	}
+
+	if update[0] != m.head {
+		n.prev = update[0]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n
+	} else {
+		m.tail = n
+	}
+	m.length++
+}
+
+// Delete deletes an element by the given key if the key exists.
+func (m *SortedMap[K, V]) Delete(k K) {
+	if m == nil || m.head == nil {
+		return
+	}
+
+	var update [sortedMapMaxLevel]*sortedMapNode[K, V]
+	n := m.findPredecessors(k, &update).next[0]
+	if n == nil || m.cmp(n.key, k) != 0 {
+		return
+	}
+
+	// The links of the removed node are kept, so an iterator standing on it is able to move on.
+	for i := range n.next {
+		update[i].next[i] = n.next[i]
+	}
+	if n.next[0] != nil {
+		n.next[0].prev = n.prev
+	} else {
+		m.tail = n.prev
+	}
+	n.removed = true
+
+	for m.level > 1 && m.head.next[m.level-1] == nil {
+		m.level--
+	}
+	m.length--
+}
+
+// Min returns the element with the smallest key.
+// If the map is empty, returns zero values and false.
+func (m *SortedMap[K, V]) Min() (K, V, bool) {
+	if m == nil || m.head == nil {
+		return sortedNodeEntry[K, V](nil)
+	}
+
+	return sortedNodeEntry(m.head.next[0])
+}
+
+// Max returns the element with the largest key.
+// If the map is empty, returns zero values and false.
+func (m *SortedMap[K, V]) Max() (K, V, bool) {
+	if m == nil {
+		return sortedNodeEntry[K, V](nil)
+	}
+
+	return sortedNodeEntry(m.tail)
+}
+
+// Floor returns the element with the largest key less than or equal to the given key.
+// If there is no such element, returns zero values and false.
+func (m *SortedMap[K, V]) Floor(k K) (K, V, bool) {
+	n := m.ceilingNode(k)
+	switch {
+	case n != nil && m.cmp(n.key, k) == 0:
+		return sortedNodeEntry(n)
+	case n != nil:
+		return sortedNodeEntry(n.prev)
+	}
+
+	return m.Max()
+}
+
+// Ceiling returns the element with the smallest key greater than or equal to the given key.
+// If there is no such element, returns zero values and false.
+func (m *SortedMap[K, V]) Ceiling(k K) (K, V, bool) {
+	return sortedNodeEntry(m.ceilingNode(k))
+}
+
+// Ascend iterates over map elements in ascending order of keys.
+// Elements may be deleted during iteration, deleted elements that have not been reached yet are not produced.
+func (m *SortedMap[K, V]) Ascend() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil || m.head == nil {
+			return
+		}
+		m.ascendFrom(m.head.next[0], nil, yield)
+	}
+}
+
+// Descend iterates over map elements in descending order of keys.
+// Elements may be deleted during iteration, deleted elements that have not been reached yet are not produced.
+func (m *SortedMap[K, V]) Descend() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+		for n := m.tail; n != nil; n = n.prev {
+			for n.removed {
+				if n = n.prev; n == nil {
+					return
+				}
+			}
+			if !yield(n.key, n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range iterates in ascending order over map elements with keys from "from" inclusive to "to" exclusive.
+func (m *SortedMap[K, V]) Range(from, to K) iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		m.ascendFrom(m.ceilingNode(from), &to, yield)
+	}
+}
+
+// ascendFrom iterates over elements starting from the given node until the key reaches "to" if it is not nil.
+func (m *SortedMap[K, V]) ascendFrom(n *sortedMapNode[K, V], to *K, yield func(K, V) bool) {
+	for ; n != nil; n = n.next[0] {
+		if n.removed {
+			continue
+		}
+		if to != nil && m.cmp(n.key, *to) >= 0 {
+			return
+		}
+		if !yield(n.key, n.value) {
+			return
+		}
+	}
+}
+
+// ceilingNode returns the node with the smallest key greater than or equal to the given key.
+func (m *SortedMap[K, V]) ceilingNode(k K) *sortedMapNode[K, V] {
+	if m == nil || m.head == nil {
+		return nil
+	}
+
+	return m.findPredecessors(k, nil).next[0]
+}
+
+// findPredecessors returns the last node with the key less than the given key.
+// If update is not nil, it is filled with such nodes for every level.
+func (m *SortedMap[K, V]) findPredecessors(k K, update *[sortedMapMaxLevel]*sortedMapNode[K, V]) *sortedMapNode[K, V] {
+	n := m.head
+	for i := m.level - 1; i >= 0; i-- {
+		for n.next[i] != nil && m.cmp(n.next[i].key, k) < 0 {
+			n = n.next[i]
+		}
+		if update != nil {
+			update[i] = n
+		}
+	}
+
+	return n
+}
+
+// sortedNodeEntry returns the key and the value of the node, and false if the node is nil.
+func sortedNodeEntry[K, V any](n *sortedMapNode[K, V]) (K, V, bool) {
+	if n == nil {
+		var (
+			k K
+			v V
+		)
+		return k, v, false
+	}
+
+	return n.key, n.value, true
+}
+
+// randomSortedMapLevel returns a random level of a new node, every next level has the probability of 1/2.
+func randomSortedMapLevel() int {
+	return min(bits.TrailingZeros64(rand.Uint64())+1, sortedMapMaxLevel)
+}
@@ -0,0 +1,270 @@
+package maps
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSortedMap_NotInitialized(t *testing.T) {
+	// Map is nil
+	var m *SortedMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Has(1))
+	assert.Equal(t, "", m.GetValue(1))
+	_, _, ok := m.Min()
+	assert.False(t, ok)
+	_, _, ok = m.Max()
+	assert.False(t, ok)
+	_, _, ok = m.Floor(1)
+	assert.False(t, ok)
+	_, _, ok = m.Ceiling(1)
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Set(1, "1") })
+	for k, v := range m.Ascend() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%s'", k, v))
+	}
+	for k, v := range m.Descend() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%s'", k, v))
+	}
+	for k, v := range m.Range(0, 10) {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%s'", k, v))
+	}
+
+	// Map is initialized without constructor
+	m = &SortedMap[int, string]{}
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Has(1))
+	_, _, ok = m.Min()
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Set(1, "1") })
+	for k, v := range m.Ascend() {
+		require.Fail(t, fmt.Sprintf("iterate over empty object, key=%d, value='%s'", k, v))
+	}
+}
+
+func TestSortedMap(t *testing.T) {
+	m := NewSortedMap[int, string]()
+	for _, k := range []int{50, 10, 40, 20, 30} {
+		m.Set(k, fmt.Sprint(k))
+	}
+
+	// Update keeps the length
+	m.Set(40, "forty")
+	assert.Equal(t, 5, m.Len())
+	assert.Equal(t, "forty", m.GetValue(40))
+	v, ok := m.GetAndCheck(35)
+	assert.True(t, v == "" && !ok)
+	assert.True(t, m.Has(10))
+
+	assert.Equal(t, []int{10, 20, 30, 40, 50}, sortedMapKeys(m.Ascend()))
+	assert.Equal(t, []int{50, 40, 30, 20, 10}, sortedMapKeys(m.Descend()))
+
+	k, v, ok := m.Min()
+	assert.True(t, k == 10 && v == "10" && ok)
+	k, v, ok = m.Max()
+	assert.True(t, k == 50 && v == "50" && ok)
+
+	m.Delete(10)
+	m.Delete(50)
+	m.Delete(35)
+	assert.Equal(t, 3, m.Len())
+	assert.False(t, m.Has(10))
+	assert.Equal(t, []int{20, 30, 40}, sortedMapKeys(m.Ascend()))
+	assert.Equal(t, []int{40, 30, 20}, sortedMapKeys(m.Descend()))
+	k, _, _ = m.Min()
+	assert.Equal(t, 20, k)
+	k, _, _ = m.Max()
+	assert.Equal(t, 40, k)
+
+	// Delete all elements
+	m.Delete(20)
+	m.Delete(30)
+	m.Delete(40)
+	assert.Equal(t, 0, m.Len())
+	_, _, ok = m.Min()
+	assert.False(t, ok)
+	_, _, ok = m.Max()
+	assert.False(t, ok)
+	assert.Empty(t, sortedMapKeys(m.Descend()))
+}
+
+func TestSortedMap_FloorCeiling(t *testing.T) {
+	m := NewSortedMap[float64, int]()
+	m.Set(1.5, 1)
+	m.Set(2.5, 2)
+	m.Set(3.5, 3)
+
+	k, v, ok := m.Floor(2.5)
+	assert.True(t, k == 2.5 && v == 2 && ok)
+	k, _, ok = m.Floor(3)
+	assert.True(t, k == 2.5 && ok)
+	k, _, ok = m.Floor(100)
+	assert.True(t, k == 3.5 && ok)
+	_, _, ok = m.Floor(1)
+	assert.False(t, ok)
+
+	k, v, ok = m.Ceiling(2.5)
+	assert.True(t, k == 2.5 && v == 2 && ok)
+	k, _, ok = m.Ceiling(2)
+	assert.True(t, k == 2.5 && ok)
+	k, _, ok = m.Ceiling(-100)
+	assert.True(t, k == 1.5 && ok)
+	_, _, ok = m.Ceiling(4)
+	assert.False(t, ok)
+}
+
+func TestSortedMap_Range(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for i := 0; i < 10; i++ {
+		m.Set(i*10, i)
+	}
+
+	// "from" is inclusive, "to" is exclusive
+	assert.Equal(t, []int{20, 30, 40}, sortedMapKeys(m.Range(20, 50)))
+	assert.Equal(t, []int{20, 30, 40, 50}, sortedMapKeys(m.Range(15, 51)))
+	assert.Equal(t, []int{0, 10}, sortedMapKeys(m.Range(-100, 20)))
+	assert.Equal(t, []int{80, 90}, sortedMapKeys(m.Range(75, 1000)))
+	assert.Empty(t, sortedMapKeys(m.Range(50, 50)))
+	assert.Empty(t, sortedMapKeys(m.Range(50, 20)))
+	assert.Empty(t, sortedMapKeys(m.Range(91, 1000)))
+
+	// Break the loop
+	keys := make([]int, 0, 2)
+	for k := range m.Range(0, 100) {
+		if len(keys) == 2 {
+			break
+		}
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []int{0, 10}, keys)
+}
+
+func TestSortedMap_DeleteWhileIterating(t *testing.T) {
+	m := NewSortedMap[int, int]()
+	for i := 0; i < 6; i++ {
+		m.Set(i, i)
+	}
+
+	// Delete the current and the next element on each step
+	keys := make([]int, 0, m.Len())
+	for k := range m.Ascend() {
+		keys = append(keys, k)
+		m.Delete(k)
+		m.Delete(k + 1)
+	}
+	assert.Equal(t, []int{0, 2, 4}, keys)
+	assert.Equal(t, 0, m.Len())
+
+	for i := 0; i < 6; i++ {
+		m.Set(i, i)
+	}
+
+	// Delete the current and the previous element on each step
+	keys = keys[:0]
+	for k := range m.Descend() {
+		keys = append(keys, k)
+		m.Delete(k)
+		m.Delete(k - 1)
+	}
+	assert.Equal(t, []int{5, 3, 1}, keys)
+	assert.Equal(t, 0, m.Len())
+}
+
+func TestNewSortedMapFunc(t *testing.T) {
+	type version struct {
+		major, minor int
+	}
+
+	// Descending order of versions
+	m := NewSortedMapFunc[version, string](func(a, b version) int {
+		return cmp.Or(cmp.Compare(b.major, a.major), cmp.Compare(b.minor, a.minor))
+	})
+	m.Set(version{1, 2}, "1.2")
+	m.Set(version{2, 0}, "2.0")
+	m.Set(version{1, 10}, "1.10")
+	m.Set(version{1, 2}, "1.2 updated")
+
+	assert.Equal(t, []version{{2, 0}, {1, 10}, {1, 2}}, sortedMapKeys(m.Ascend()))
+	assert.Equal(t, "1.2 updated", m.GetValue(version{1, 2}))
+
+	k, _, ok := m.Floor(version{1, 5})
+	assert.True(t, k == version{1, 10} && ok)
+	k, _, ok = m.Ceiling(version{1, 5})
+	assert.True(t, k == version{1, 2} && ok)
+
+	// Case-insensitive keys are considered to be the same key
+	words := NewSortedMapFunc[string, int](func(a, b string) int {
+		return cmp.Compare(strings.ToLower(a), strings.ToLower(b))
+	})
+	words.Set("Banana", 1)
+	words.Set("apple", 2)
+	words.Set("BANANA", 3)
+	assert.Equal(t, 2, words.Len())
+	assert.Equal(t, 3, words.GetValue("banana"))
+}
+
+func TestSortedMap_Random(t *testing.T) {
+	rnd := rand.New(rand.NewPCG(1, 2))
+	m := NewSortedMap[int, int]()
+	expected := make(map[int]int)
+
+	for i := 0; i < 5000; i++ {
+		k := rnd.IntN(1000)
+		if rnd.IntN(3) == 0 {
+			m.Delete(k)
+			delete(expected, k)
+		} else {
+			m.Set(k, i)
+			expected[k] = i
+		}
+	}
+
+	keys := make([]int, 0, len(expected))
+	for k := range expected {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	require.Equal(t, len(expected), m.Len())
+	assert.Equal(t, keys, sortedMapKeys(m.Ascend()))
+	descending := slices.Clone(keys)
+	slices.Reverse(descending)
+	assert.Equal(t, descending, sortedMapKeys(m.Descend()))
+	for k, v := range m.Ascend() {
+		require.Equal(t, expected[k], v)
+	}
+}
+
+func sortedMapKeys[K, V any](seq iter.Seq2[K, V]) []K {
+	keys := make([]K, 0)
+	for k := range seq {
+		keys = append(keys, k)
+	}
+
+	return keys
+}
+
+func BenchmarkSortedMap(b *testing.B) {
+	size := 1000
+	m := NewSortedMap[int, int]()
+
+	for i := 0; i < b.N; i++ {
+		for i := 0; i < size; i++ {
+			m.Set(i*7919%size, i)
+		}
+
+		for j := size - 1; j >= 0; j-- {
+			m.Delete(j)
+		}
+	}
+}
@@ -0,0 +1,176 @@
+package maps
+
+import (
+	"iter"
+	"sync"
+)
+
+// SyncOrderedMap is an OrderedMap that is safe for concurrent use by multiple goroutines.
+// Iteration is performed over a consistent snapshot of the map, so the lock isn't held while the loop body runs.
+// To use this object, it is absolutely necessary to create the object using the NewSyncOrderedMap constructor.
+type SyncOrderedMap[K comparable, V any] struct {
+	mu sync.RWMutex
+	m  *OrderedMap[K, V]
+}
+
+// NewSyncOrderedMap allocates and initializes new object of type SyncOrderedMap and returns a pointer to it.
+// It is absolutely necessary to create the SyncOrderedMap object using this constructor.
+func NewSyncOrderedMap[K comparable, V any](size int) *SyncOrderedMap[K, V] {
+	m := SyncOrderedMap[K, V]{
+		m: NewOrderedMap[K, V](size),
+	}
+
+	return &m
+}
+
+// Len returns the length of map.
+func (m *SyncOrderedMap[_, _]) Len() int {
+	if m == nil {
+		return 0
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Len()
+}
+
+// Has checks if the map contains the given key.
+func (m *SyncOrderedMap[K, _]) Has(k K) bool {
+	if m == nil {
+		return false
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.Has(k)
+}
+
+// GetValue returns a value by the given key.
+// If m is nil or k is not found, returns zero value of type V.
+func (m *SyncOrderedMap[K, V]) GetValue(k K) V {
+	v, _ := m.GetAndCheck(k)
+
+	return v
+}
+
+// GetAndCheck like GetValue and Has methods,
+// returns a value by the given key and checks if the map contains the key.
+func (m *SyncOrderedMap[K, V]) GetAndCheck(k K) (V, bool) {
+	if m == nil {
+		var v V
+		return v, false
+	}
+
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.m.GetAndCheck(k)
+}
+
+// Set sets the given key-value pair into the map.
+// Panics if m is not initialized by NewSyncOrderedMap constructor.
+func (m *SyncOrderedMap[K, V]) Set(k K, v V) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Set(k, v)
+}
+
+// Delete deletes an element by the given key if the key exists.
+func (m *SyncOrderedMap[K, _]) Delete(k K) {
+	if m == nil {
+		return
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.m.Delete(k)
+}
+
+// GetOrSet returns the existing value for the key if present.
+// Otherwise, it sets the given value and returns it.
+// The loaded result is true if the value was loaded, false if set.
+// Panics if m is not initialized by NewSyncOrderedMap constructor.
+func (m *SyncOrderedMap[K, V]) GetOrSet(k K, v V) (actual V, loaded bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if actual, loaded = m.m.GetAndCheck(k); loaded {
+		return actual, true
+	}
+	m.m.Set(k, v)
+
+	return v, false
+}
+
+// Update atomically replaces the value by the given key with the result of fn and returns the new value.
+// fn receives the current value and whether the key exists; a new key is placed at the end of the map.
+// fn is called under the lock, so it must not access the map.
+// Panics if m is not initialized by NewSyncOrderedMap constructor.
+func (m *SyncOrderedMap[K, V]) Update(k K, fn func(v V, ok bool) V) V {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v := fn(m.m.GetAndCheck(k))
+	m.m.Set(k, v)
+
+	return v
+}
+
+// CompareAndDelete deletes the element by the given key if its value is equal to old.
+// Returns true if the element was deleted.
+// Like sync.Map.CompareAndDelete, panics if the value is not of a comparable type.
+func (m *SyncOrderedMap[K, V]) CompareAndDelete(k K, old V) bool {
+	if m == nil {
+		return false
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	v, ok := m.m.GetAndCheck(k)
+	if !ok || any(v) != any(old) {
+		return false
+	}
+	m.m.Delete(k)
+
+	return true
+}
+
+// Iterate iterates over map elements in the order they are inserted.
+// The elements are copied under the lock when the iteration starts,
+// so changes made during the iteration are not visible to it.
+func (m *SyncOrderedMap[K, V]) Iterate() iter.Seq2[K, V] {
+	return func(yield func(K, V) bool) {
+		if m == nil {
+			return
+		}
+
+		for _, e := range m.snapshot() {
+			if !yield(e.key, e.value) {
+				return
+			}
+		}
+	}
+}
+
+type syncOrderedMapEntry[K comparable, V any] struct {
+	key   K
+	value V
+}
+
+// snapshot copies the elements of the map in the order they are inserted.
+func (m *SyncOrderedMap[K, V]) snapshot() []syncOrderedMapEntry[K, V] {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	entries := make([]syncOrderedMapEntry[K, V], 0, m.m.Len())
+	for k, v := range m.m.Iterate() {
+		entries = append(entries, syncOrderedMapEntry[K, V]{key: k, value: v})
+	}
+
+	return entries
+}
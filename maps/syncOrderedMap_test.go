@@ -0,0 +1,198 @@
+package maps
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyncOrderedMap_NotInitialized(t *testing.T) {
+	// Map is nil
+	var m *SyncOrderedMap[int, string]
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Has(1))
+	assert.Equal(t, "", m.GetValue(1))
+	assert.False(t, m.CompareAndDelete(1, ""))
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Set(1, "1") })
+	for k, v := range m.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over nil object, key=%d, value='%s'", k, v))
+	}
+
+	// Map is initialized without constructor
+	m = &SyncOrderedMap[int, string]{}
+	assert.Equal(t, 0, m.Len())
+	assert.False(t, m.Has(1))
+	v, ok := m.GetAndCheck(1)
+	assert.Equal(t, "", v)
+	assert.False(t, ok)
+	assert.NotPanics(t, func() { m.Delete(1) })
+	assert.Panics(t, func() { m.Set(1, "1") })
+	assert.Panics(t, func() { m.GetOrSet(1, "1") })
+	for k, v := range m.Iterate() {
+		require.Fail(t, fmt.Sprintf("iterate over empty object, key=%d, value='%s'", k, v))
+	}
+}
+
+func TestSyncOrderedMap(t *testing.T) {
+	m := NewSyncOrderedMap[string, int](3)
+	m.Set("c", 1)
+	m.Set("a", 2)
+	m.Set("b", 3)
+	m.Delete("a")
+	m.Set("a", 4)
+
+	assert.Equal(t, 3, m.Len())
+	assert.True(t, m.Has("a"))
+	assert.False(t, m.Has("d"))
+	assert.Equal(t, 4, m.GetValue("a"))
+	v, ok := m.GetAndCheck("b")
+	assert.Equal(t, 3, v)
+	assert.True(t, ok)
+
+	keys := make([]string, 0, m.Len())
+	for k := range m.Iterate() {
+		keys = append(keys, k)
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, keys)
+}
+
+func TestSyncOrderedMap_GetOrSet(t *testing.T) {
+	m := NewSyncOrderedMap[string, int](1)
+
+	// Set absent key
+	v, loaded := m.GetOrSet("a", 1)
+	assert.Equal(t, 1, v)
+	assert.False(t, loaded)
+
+	// Load existing key
+	v, loaded = m.GetOrSet("a", 2)
+	assert.Equal(t, 1, v)
+	assert.True(t, loaded)
+	assert.Equal(t, 1, m.GetValue("a"))
+}
+
+func TestSyncOrderedMap_Update(t *testing.T) {
+	m := NewSyncOrderedMap[string, int](2)
+	m.Set("a", 1)
+
+	// Update existing key
+	v := m.Update("a", func(v int, ok bool) int {
+		assert.True(t, ok)
+		return v + 10
+	})
+	assert.Equal(t, 11, v)
+	assert.Equal(t, 11, m.GetValue("a"))
+
+	// Update absent key
+	v = m.Update("b", func(v int, ok bool) int {
+		assert.False(t, ok)
+		assert.Equal(t, 0, v)
+		return 5
+	})
+	assert.Equal(t, 5, v)
+	assert.Equal(t, 2, m.Len())
+}
+
+func TestSyncOrderedMap_CompareAndDelete(t *testing.T) {
+	m := NewSyncOrderedMap[string, int](1)
+	m.Set("a", 1)
+
+	// Value doesn't match
+	assert.False(t, m.CompareAndDelete("a", 2))
+	assert.True(t, m.Has("a"))
+
+	// Key doesn't exist
+	assert.False(t, m.CompareAndDelete("b", 0))
+
+	// Value matches
+	assert.True(t, m.CompareAndDelete("a", 1))
+	assert.False(t, m.Has("a"))
+
+	// Panics on non-comparable values
+	slices := NewSyncOrderedMap[string, []int](1)
+	slices.Set("a", []int{1})
+	assert.Panics(t, func() { slices.CompareAndDelete("a", []int{1}) })
+}
+
+func TestSyncOrderedMap_IterateSnapshot(t *testing.T) {
+	m := NewSyncOrderedMap[int, int](3)
+	for i := 0; i < 3; i++ {
+		m.Set(i, i)
+	}
+
+	// Changes made during iteration don't affect it and don't deadlock
+	keys := make([]int, 0, m.Len())
+	values := make([]int, 0, m.Len())
+	for k, v := range m.Iterate() {
+		keys = append(keys, k)
+		values = append(values, v)
+		m.Delete(k + 1)
+		m.Set(k+10, k)
+		m.Update(0, func(v int, _ bool) int { return v + 100 })
+	}
+	assert.Equal(t, []int{0, 1, 2}, keys)
+	assert.Equal(t, []int{0, 1, 2}, values)
+	assert.Equal(t, 4, m.Len())
+	assert.Equal(t, 300, m.GetValue(0))
+}
+
+func TestSyncOrderedMap_Concurrent(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 100
+	)
+
+	m := NewSyncOrderedMap[int, int](keys)
+
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < keys; i++ {
+				m.Update(i, func(v int, _ bool) int { return v + 1 })
+				m.GetOrSet(i+keys, w)
+				m.Set(i+2*keys, w)
+				m.CompareAndDelete(i+2*keys, w)
+				m.Has(i)
+				m.GetValue(i)
+				m.Len()
+				for range m.Iterate() {
+					break
+				}
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	for i := 0; i < keys; i++ {
+		assert.Equal(t, workers, m.GetValue(i))
+		assert.True(t, m.Has(i+keys))
+	}
+	assert.LessOrEqual(t, m.Len(), 3*keys)
+}
+
+func BenchmarkSyncOrderedMap(b *testing.B) {
+	size := 1000
+	m := NewSyncOrderedMap[int, int](size)
+	for i := 0; i < size; i++ {
+		m.Set(i, i)
+	}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			if i%10 == 0 {
+				m.Set(i%size, i)
+			} else {
+				m.GetValue(i % size)
+			}
+			i++
+		}
+	})
+}
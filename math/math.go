@@ -1,14 +1,15 @@
 package math
 
 import (
+	"cmp"
 	"math"
 
 	"github.com/nodasoft/go-utils/generics"
 )
 
 // Max return maximum value from presented.
-func Max[T generics.Numeric](n ...T) T {
-	// TODO: Is it necessary to check if the passed slice is empty?
+// Panics if no values are presented, use slices.SafeMax for possibly empty slices.
+func Max[T cmp.Ordered](n ...T) T {
 	m := n[0]
 	for i := 1; i < len(n); i++ {
 		if n[i] > m {
@@ -20,7 +21,8 @@ func Max[T generics.Numeric](n ...T) T {
 }
 
 // Min return minimal value from presented.
-func Min[T generics.Numeric](n ...T) T {
+// Panics if no values are presented, use slices.SafeMin for possibly empty slices.
+func Min[T cmp.Ordered](n ...T) T {
 	m := n[0]
 	for i := 1; i < len(n); i++ {
 		if n[i] < m {
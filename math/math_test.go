@@ -2,6 +2,7 @@ package math
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -17,6 +18,10 @@ func TestMax(t *testing.T) {
 	int8Val := Max([]int8{-2, 3, 15, 28, 4}...)
 	var expInt8 int8 = 28
 	assert.Equal(t, expInt8, int8Val)
+
+	assert.Equal(t, "pear", Max("apple", "pear", "banana"))
+	assert.Equal(t, time.Minute, Max(time.Second, time.Minute))
+	assert.Panics(t, func() { Max[int]() })
 }
 
 func BenchmarkMax(b *testing.B) {
@@ -41,6 +46,9 @@ func TestMin(t *testing.T) {
 	minInt8 := Min([]int8{4, -2, 3, 15, 28, 4}...)
 	var expInt8 int8 = -2
 	assert.Equal(t, expInt8, minInt8)
+
+	assert.Equal(t, "apple", Min("pear", "apple", "banana"))
+	assert.Panics(t, func() { Min[string]() })
 }
 
 func BenchmarkMin(b *testing.B) {
@@ -0,0 +1,261 @@
+package sets
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"iter"
+	"reflect"
+	"slices"
+)
+
+// Set represents a set of unique comparable values.
+// Reading from a nil Set is allowed, but adding to it panics, so create the set using New, From or make.
+type Set[T comparable] map[T]struct{}
+
+// New returns a new set containing the given items.
+func New[T comparable](items ...T) Set[T] {
+	s := make(Set[T], len(items))
+	s.Add(items...)
+
+	return s
+}
+
+// From returns a new set containing the items produced by the iterator.
+func From[T comparable](seq iter.Seq[T]) Set[T] {
+	s := make(Set[T])
+	for item := range seq {
+		s[item] = struct{}{}
+	}
+
+	return s
+}
+
+// Add adds the given items to the set.
+// Panics if the set is nil.
+func (s Set[T]) Add(items ...T) {
+	for _, item := range items {
+		s[item] = struct{}{}
+	}
+}
+
+// Remove removes the given items from the set.
+func (s Set[T]) Remove(items ...T) {
+	for _, item := range items {
+		delete(s, item)
+	}
+}
+
+// Has checks if the set contains the given item.
+func (s Set[T]) Has(item T) bool {
+	_, ok := s[item]
+
+	return ok
+}
+
+// Len returns the number of items in the set.
+func (s Set[T]) Len() int {
+	return len(s)
+}
+
+// Clone returns a copy of the set.
+func (s Set[T]) Clone() Set[T] {
+	result := make(Set[T], len(s))
+	for item := range s {
+		result[item] = struct{}{}
+	}
+
+	return result
+}
+
+// Union returns a new set with items present in any of the sets.
+func (s Set[T]) Union(other Set[T]) Set[T] {
+	result := make(Set[T], max(len(s), len(other)))
+	for item := range s {
+		result[item] = struct{}{}
+	}
+	for item := range other {
+		result[item] = struct{}{}
+	}
+
+	return result
+}
+
+// Intersect returns a new set with items present in both sets.
+func (s Set[T]) Intersect(other Set[T]) Set[T] {
+	small, large := s, other
+	if len(small) > len(large) {
+		small, large = large, small
+	}
+
+	result := make(Set[T], len(small))
+	for item := range small {
+		if large.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// Difference returns a new set with items of s that are absent in other.
+func (s Set[T]) Difference(other Set[T]) Set[T] {
+	result := make(Set[T], len(s))
+	for item := range s {
+		if !other.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// SymmetricDifference returns a new set with items present in exactly one of the sets.
+func (s Set[T]) SymmetricDifference(other Set[T]) Set[T] {
+	result := make(Set[T])
+	for item := range s {
+		if !other.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+	for item := range other {
+		if !s.Has(item) {
+			result[item] = struct{}{}
+		}
+	}
+
+	return result
+}
+
+// IsSubset checks if every item of s is present in other.
+func (s Set[T]) IsSubset(other Set[T]) bool {
+	if len(s) > len(other) {
+		return false
+	}
+	for item := range s {
+		if !other.Has(item) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSuperset checks if every item of other is present in s.
+func (s Set[T]) IsSuperset(other Set[T]) bool {
+	return other.IsSubset(s)
+}
+
+// Equal checks if the sets contain the same items.
+func (s Set[T]) Equal(other Set[T]) bool {
+	return len(s) == len(other) && s.IsSubset(other)
+}
+
+// All returns an iterator over the set items. The order is not specified.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for item := range s {
+			if !yield(item) {
+				return
+			}
+		}
+	}
+}
+
+// ToSlice returns a slice of the set items. The order is not specified.
+func (s Set[T]) ToSlice() []T {
+	result := make([]T, 0, len(s))
+	for item := range s {
+		result = append(result, item)
+	}
+
+	return result
+}
+
+// Sorted returns a slice of the set items in ascending order.
+func Sorted[T cmp.Ordered](s Set[T]) []T {
+	result := s.ToSlice()
+	slices.Sort(result)
+
+	return result
+}
+
+// MarshalJSON encodes the set as a JSON array.
+// Items of string and numeric kinds are sorted in ascending order,
+// other items are sorted by their JSON encoding, so the output is always deterministic.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	if s == nil {
+		return []byte("null"), nil
+	}
+
+	items := s.ToSlice()
+	encoded := make([][]byte, len(items))
+	for i, item := range items {
+		data, err := json.Marshal(item)
+		if err != nil {
+			return nil, err
+		}
+		encoded[i] = data
+	}
+
+	order := make([]int, len(items))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortFunc(order, func(i, j int) int {
+		return compareJSONItems(items[i], items[j], encoded[i], encoded[j])
+	})
+
+	buf := bytes.NewBuffer(make([]byte, 0, 2+len(items)*8))
+	buf.WriteByte('[')
+	for i, idx := range order {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(encoded[idx])
+	}
+	buf.WriteByte(']')
+
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON decodes a JSON array into the set, adding the items to the existing ones.
+// A nil set is allocated, null is a no-op.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	var items []T
+	if err := json.Unmarshal(data, &items); err != nil {
+		return err
+	}
+	if items == nil {
+		return nil
+	}
+
+	if *s == nil {
+		*s = make(Set[T], len(items))
+	}
+	s.Add(items...)
+
+	return nil
+}
+
+// compareJSONItems compares items of string and numeric kinds by value and other items by their JSON encoding.
+// Items of different kinds are ordered by kind.
+func compareJSONItems[T comparable](a, b T, encodedA, encodedB []byte) int {
+	va, vb := reflect.ValueOf(a), reflect.ValueOf(b)
+	if c := cmp.Compare(va.Kind(), vb.Kind()); c != 0 {
+		return c
+	}
+
+	switch va.Kind() {
+	case reflect.String:
+		return cmp.Compare(va.String(), vb.String())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return cmp.Compare(va.Int(), vb.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return cmp.Compare(va.Uint(), vb.Uint())
+	case reflect.Float32, reflect.Float64:
+		return cmp.Compare(va.Float(), vb.Float())
+	}
+
+	return bytes.Compare(encodedA, encodedB)
+}
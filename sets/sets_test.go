@@ -0,0 +1,195 @@
+package sets
+
+import (
+	"encoding/json"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNew(t *testing.T) {
+	s := New(1, 2, 2, 3)
+	assert.Equal(t, 3, s.Len())
+	assert.True(t, s.Has(2))
+	assert.False(t, s.Has(4))
+
+	// Empty set
+	s = New[int]()
+	assert.Equal(t, 0, s.Len())
+	assert.NotNil(t, s)
+}
+
+func TestFrom(t *testing.T) {
+	s := From(slices.Values([]string{"a", "b", "a"}))
+	assert.True(t, s.Equal(New("a", "b")))
+}
+
+func TestSet_AddRemove(t *testing.T) {
+	s := New[int]()
+	s.Add(1, 2, 3)
+	s.Add(3)
+	assert.Equal(t, 3, s.Len())
+
+	s.Remove(1, 4)
+	assert.Equal(t, 2, s.Len())
+	assert.False(t, s.Has(1))
+
+	// Nil set
+	var nilSet Set[int]
+	assert.False(t, nilSet.Has(1))
+	assert.Equal(t, 0, nilSet.Len())
+	assert.NotPanics(t, func() { nilSet.Remove(1) })
+	assert.Panics(t, func() { nilSet.Add(1) })
+}
+
+func TestSet_Clone(t *testing.T) {
+	s := New(1, 2)
+	c := s.Clone()
+	c.Add(3)
+	assert.Equal(t, 2, s.Len())
+	assert.Equal(t, 3, c.Len())
+}
+
+func TestSet_Algebra(t *testing.T) {
+	a := New(1, 2, 3, 4)
+	b := New(3, 4, 5)
+
+	assert.Equal(t, New(1, 2, 3, 4, 5), a.Union(b))
+	assert.Equal(t, New(3, 4), a.Intersect(b))
+	assert.Equal(t, New(3, 4), b.Intersect(a))
+	assert.Equal(t, New(1, 2), a.Difference(b))
+	assert.Equal(t, New(5), b.Difference(a))
+	assert.Equal(t, New(1, 2, 5), a.SymmetricDifference(b))
+
+	// Operands are not modified
+	assert.Equal(t, New(1, 2, 3, 4), a)
+	assert.Equal(t, New(3, 4, 5), b)
+
+	// Nil sets
+	var nilSet Set[int]
+	assert.Equal(t, a, a.Union(nilSet))
+	assert.Equal(t, New[int](), a.Intersect(nilSet))
+	assert.Equal(t, a, a.Difference(nilSet))
+	assert.Equal(t, New[int](), nilSet.Difference(a))
+	assert.Equal(t, a, nilSet.SymmetricDifference(a))
+}
+
+func TestSet_Compare(t *testing.T) {
+	a := New(1, 2)
+	b := New(1, 2, 3)
+
+	assert.True(t, a.IsSubset(b))
+	assert.False(t, b.IsSubset(a))
+	assert.True(t, b.IsSuperset(a))
+	assert.False(t, a.IsSuperset(b))
+	assert.True(t, a.IsSubset(a))
+	assert.False(t, New(1, 4).IsSubset(b))
+
+	assert.True(t, a.Equal(New(2, 1)))
+	assert.False(t, a.Equal(b))
+	assert.False(t, a.Equal(New(1, 3)))
+
+	// Empty and nil sets
+	var nilSet Set[int]
+	assert.True(t, nilSet.IsSubset(a))
+	assert.True(t, nilSet.Equal(New[int]()))
+	assert.False(t, a.IsSubset(nilSet))
+}
+
+func TestSet_Export(t *testing.T) {
+	s := New(3, 1, 2)
+
+	items := make([]int, 0, s.Len())
+	for item := range s.All() {
+		items = append(items, item)
+	}
+	assert.ElementsMatch(t, []int{1, 2, 3}, items)
+
+	// Break the loop
+	for range s.All() {
+		break
+	}
+
+	assert.ElementsMatch(t, []int{1, 2, 3}, s.ToSlice())
+	assert.Equal(t, []int{1, 2, 3}, Sorted(s))
+	assert.Equal(t, []string{"a", "b", "c"}, Sorted(New("c", "a", "b")))
+	assert.Equal(t, []int{}, Sorted[int](nil))
+}
+
+func TestSet_MarshalJSON(t *testing.T) {
+	data, err := json.Marshal(New(10, 9, 1))
+	require.NoError(t, err)
+	assert.Equal(t, `[1,9,10]`, string(data))
+
+	data, err = json.Marshal(New("b", "a"))
+	require.NoError(t, err)
+	assert.Equal(t, `["a","b"]`, string(data))
+
+	// Items that are not ordered are sorted by encoding
+	type point struct {
+		X, Y int
+	}
+	data, err = json.Marshal(New(point{2, 1}, point{1, 2}))
+	require.NoError(t, err)
+	assert.Equal(t, `[{"X":1,"Y":2},{"X":2,"Y":1}]`, string(data))
+
+	// Items of different kinds
+	data, err = json.Marshal(New[any]("a", 2, 1, nil, true))
+	require.NoError(t, err)
+	assert.Equal(t, `[null,true,1,2,"a"]`, string(data))
+
+	// Empty and nil sets
+	data, err = json.Marshal(New[int]())
+	require.NoError(t, err)
+	assert.Equal(t, `[]`, string(data))
+
+	type request struct {
+		IDs Set[int] `json:"ids"`
+	}
+	data, err = json.Marshal(request{})
+	require.NoError(t, err)
+	assert.Equal(t, `{"ids":null}`, string(data))
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	type request struct {
+		IDs Set[int] `json:"ids"`
+	}
+
+	var r request
+	require.NoError(t, json.Unmarshal([]byte(`{"ids": [3, 1, 3]}`), &r))
+	assert.Equal(t, New(1, 3), r.IDs)
+
+	// Items are added to the existing ones
+	require.NoError(t, json.Unmarshal([]byte(`{"ids": [2]}`), &r))
+	assert.Equal(t, New(1, 2, 3), r.IDs)
+
+	// Null is a no-op
+	require.NoError(t, json.Unmarshal([]byte(`{"ids": null}`), &r))
+	assert.Equal(t, New(1, 2, 3), r.IDs)
+
+	// Empty array allocates an empty set
+	var s Set[int]
+	require.NoError(t, json.Unmarshal([]byte(`[]`), &s))
+	assert.NotNil(t, s)
+
+	// Wrong types
+	assert.Error(t, json.Unmarshal([]byte(`{"ids": ["a"]}`), &r))
+	assert.Error(t, json.Unmarshal([]byte(`{"ids": {}}`), &r))
+}
+
+func BenchmarkSet_Intersect(b *testing.B) {
+	a := New[int]()
+	other := New[int]()
+	for i := 0; i < 1000; i++ {
+		a.Add(i)
+		other.Add(i * 2)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		a.Intersect(other)
+	}
+}
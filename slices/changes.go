@@ -0,0 +1,56 @@
+package slices
+
+// ChangeSet is the difference between two versions of a slice.
+type ChangeSet[T any] struct {
+	Added     []T // elements of the new version without a match in the old one, in the order of the new version
+	Removed   []T // elements of the old version without a match in the new one, in the order of the old version
+	Unchanged []T // elements of the new version matched with the old one, in the order of the new version
+}
+
+// IsEmpty checks if nothing is added or removed.
+func (c ChangeSet[_]) IsEmpty() bool {
+	return len(c.Added) == 0 && len(c.Removed) == 0
+}
+
+// Changes returns elements added to and removed from the old version to get the new one, e.g. rows to insert
+// and to delete when synchronizing a list with a database.
+// Duplicates are taken into account: every occurrence in the new version matches one occurrence in the old version,
+// so a value present twice in the old version and once in the new one is both unchanged and removed.
+// To compare the versions as sets, remove duplicates with UniqueCopy first.
+func Changes[T comparable](from, to []T) ChangeSet[T] {
+	return ChangesBy(from, to, func(v T) T { return v })
+}
+
+// ChangesBy is like Changes, but matches elements by keys returned by keyFn.
+// Unchanged contains elements of the new version, which may differ from the matched old ones in other fields.
+func ChangesBy[T any, K comparable](from, to []T, keyFn func(T) K) ChangeSet[T] {
+	var c ChangeSet[T]
+
+	counts := make(map[K]int, len(from))
+	for _, v := range from {
+		counts[keyFn(v)]++
+	}
+
+	// occurrences of a key in the old version matched with the new one
+	matched := make(map[K]int, len(to))
+	for _, v := range to {
+		k := keyFn(v)
+		if matched[k] < counts[k] {
+			matched[k]++
+			c.Unchanged = append(c.Unchanged, v)
+		} else {
+			c.Added = append(c.Added, v)
+		}
+	}
+
+	for _, v := range from {
+		k := keyFn(v)
+		if matched[k] > 0 {
+			matched[k]--
+		} else {
+			c.Removed = append(c.Removed, v)
+		}
+	}
+
+	return c
+}
@@ -0,0 +1,80 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChanges(t *testing.T) {
+	cases := []struct {
+		name     string
+		from, to []int
+		expected ChangeSet[int]
+	}{
+		{
+			name:     "added and removed",
+			from:     []int{1, 2, 3, 4},
+			to:       []int{5, 3, 1, 6},
+			expected: ChangeSet[int]{Added: []int{5, 6}, Removed: []int{2, 4}, Unchanged: []int{3, 1}},
+		},
+		{
+			name:     "duplicates removed",
+			from:     []int{1, 2, 1, 1},
+			to:       []int{1},
+			expected: ChangeSet[int]{Removed: []int{2, 1, 1}, Unchanged: []int{1}},
+		},
+		{
+			name:     "duplicates added",
+			from:     []int{2, 1},
+			to:       []int{1, 1, 2, 1},
+			expected: ChangeSet[int]{Added: []int{1, 1}, Unchanged: []int{1, 2}},
+		},
+		{
+			name:     "equal",
+			from:     []int{1, 2},
+			to:       []int{2, 1},
+			expected: ChangeSet[int]{Unchanged: []int{2, 1}},
+		},
+		{
+			name:     "from empty",
+			from:     nil,
+			to:       []int{1, 2},
+			expected: ChangeSet[int]{Added: []int{1, 2}},
+		},
+		{
+			name:     "to empty",
+			from:     []int{1, 2},
+			to:       []int{},
+			expected: ChangeSet[int]{Removed: []int{1, 2}},
+		},
+		{
+			name:     "both empty",
+			expected: ChangeSet[int]{},
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, Changes(c.from, c.to))
+		})
+	}
+
+	assert.True(t, Changes([]int{1}, []int{1}).IsEmpty())
+	assert.False(t, Changes([]int{1}, []int{1, 1}).IsEmpty())
+	assert.False(t, Changes([]int{1}, nil).IsEmpty())
+}
+
+func TestChangesBy(t *testing.T) {
+	type tag struct {
+		ID   int
+		Name string
+	}
+	from := []tag{{1, "a"}, {2, "b"}, {3, "c"}}
+	to := []tag{{3, "c"}, {1, "A"}, {4, "d"}}
+
+	c := ChangesBy(from, to, func(t tag) int { return t.ID })
+	assert.Equal(t, []tag{{4, "d"}}, c.Added)
+	assert.Equal(t, []tag{{2, "b"}}, c.Removed)
+	// Elements of the new version
+	assert.Equal(t, []tag{{3, "c"}, {1, "A"}}, c.Unchanged)
+}
@@ -0,0 +1,80 @@
+package slices
+
+import (
+	"iter"
+)
+
+// Chunk splits the slice into consecutive chunks of size elements, the last chunk may be shorter.
+// Chunks share memory with the original slice, but their capacity is clipped, so appending to a chunk doesn't
+// overwrite the next one. If size <= 0, the whole slice is returned as a single chunk.
+// If the slice is empty, returns nil.
+func Chunk[T any](sl []T, size int) [][]T {
+	if len(sl) == 0 {
+		return nil
+	}
+	if size <= 0 || size > len(sl) {
+		size = len(sl)
+	}
+
+	chunks := make([][]T, 0, (len(sl)+size-1)/size)
+	for chunk := range ChunkSeq(sl, size) {
+		chunks = append(chunks, chunk)
+	}
+
+	return chunks
+}
+
+// ChunkSeq returns an iterator over consecutive chunks of size elements, like Chunk does,
+// without allocating a slice of chunks.
+func ChunkSeq[T any](sl []T, size int) iter.Seq[[]T] {
+	if size <= 0 {
+		size = len(sl)
+	}
+
+	return func(yield func([]T) bool) {
+		for i := 0; i < len(sl); i += size {
+			end := min(i+size, len(sl))
+			if !yield(sl[i:end:end]) {
+				return
+			}
+		}
+	}
+}
+
+// Window returns all sliding windows of size consecutive elements: sl[0:size], sl[1:size+1] and so on.
+// Windows share memory with the original slice, but their capacity is clipped.
+// If size <= 0 or the slice is shorter than size, returns nil.
+func Window[T any](sl []T, size int) [][]T {
+	if size <= 0 || len(sl) < size {
+		return nil
+	}
+
+	windows := make([][]T, 0, len(sl)-size+1)
+	for i := 0; i+size <= len(sl); i++ {
+		windows = append(windows, sl[i:i+size:i+size])
+	}
+
+	return windows
+}
+
+// Paginate returns the items of the given page and the total number of pages. Pages are numbered from 1.
+// If perPage <= 0, all items are on a single page. If the page is out of range or the slice is empty, items are nil.
+// Items share memory with the original slice, but their capacity is clipped.
+func Paginate[T any](sl []T, page, perPage int) ([]T, int) {
+	if len(sl) == 0 {
+		return nil, 0
+	}
+	if perPage <= 0 {
+		perPage = len(sl)
+	}
+
+	pages := (len(sl) + perPage - 1) / perPage
+	if page < 1 || page > pages {
+		return nil, pages
+	}
+
+	start := (page - 1) * perPage
+	end := min(start+perPage, len(sl))
+
+	return sl[start:end:end], pages
+}
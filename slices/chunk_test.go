@@ -0,0 +1,115 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChunk(t *testing.T) {
+	sl := []int{1, 2, 3, 4, 5}
+
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, Chunk(sl, 2))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(sl, 5))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(sl, 10))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}, {5}}, Chunk(sl, 1))
+
+	// size <= 0 means a single chunk
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(sl, 0))
+	assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, Chunk(sl, -1))
+
+	// Empty input
+	assert.Nil(t, Chunk([]int{}, 2))
+	assert.Nil(t, Chunk[int](nil, 0))
+
+	// Appending to a chunk doesn't overwrite the next one
+	chunks := Chunk(sl, 2)
+	_ = append(chunks[0], 10)
+	assert.Equal(t, []int{3, 4}, chunks[1])
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sl)
+}
+
+func TestChunkSeq(t *testing.T) {
+	sl := []int{1, 2, 3, 4, 5}
+
+	var chunks [][]int
+	for chunk := range ChunkSeq(sl, 2) {
+		chunks = append(chunks, chunk)
+	}
+	assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, chunks)
+
+	// Early stop
+	chunks = nil
+	for chunk := range ChunkSeq(sl, 2) {
+		chunks = append(chunks, chunk)
+		break
+	}
+	assert.Equal(t, [][]int{{1, 2}}, chunks)
+
+	// size <= 0 means a single chunk, the iterator is reusable
+	seq := ChunkSeq(sl, 0)
+	for range 2 {
+		chunks = nil
+		for chunk := range seq {
+			chunks = append(chunks, chunk)
+		}
+		assert.Equal(t, [][]int{{1, 2, 3, 4, 5}}, chunks)
+	}
+
+	for chunk := range ChunkSeq([]int{}, 2) {
+		assert.Fail(t, "chunk of empty slice", chunk)
+	}
+}
+
+func TestWindow(t *testing.T) {
+	sl := []int{1, 2, 3, 4}
+
+	assert.Equal(t, [][]int{{1, 2}, {2, 3}, {3, 4}}, Window(sl, 2))
+	assert.Equal(t, [][]int{{1, 2, 3, 4}}, Window(sl, 4))
+	assert.Equal(t, [][]int{{1}, {2}, {3}, {4}}, Window(sl, 1))
+	assert.Nil(t, Window(sl, 5))
+	assert.Nil(t, Window(sl, 0))
+	assert.Nil(t, Window([]int{}, 1))
+
+	windows := Window(sl, 2)
+	_ = append(windows[0], 10)
+	assert.Equal(t, []int{1, 2, 3, 4}, sl)
+}
+
+func TestPaginate(t *testing.T) {
+	sl := []int{1, 2, 3, 4, 5}
+
+	cases := []struct {
+		name          string
+		page, perPage int
+		items         []int
+		pages         int
+	}{
+		{"first page", 1, 2, []int{1, 2}, 3},
+		{"middle page", 2, 2, []int{3, 4}, 3},
+		{"last page", 3, 2, []int{5}, 3},
+		{"page after last", 4, 2, nil, 3},
+		{"zero page", 0, 2, nil, 3},
+		{"negative page", -1, 2, nil, 3},
+		{"exact pages", 1, 5, []int{1, 2, 3, 4, 5}, 1},
+		{"perPage <= 0", 1, 0, []int{1, 2, 3, 4, 5}, 1},
+		{"perPage <= 0, second page", 2, -1, nil, 1},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			items, pages := Paginate(sl, c.page, c.perPage)
+			assert.Equal(t, c.items, items)
+			assert.Equal(t, c.pages, pages)
+		})
+	}
+
+	// Empty input
+	items, pages := Paginate([]int{}, 1, 10)
+	assert.Nil(t, items)
+	assert.Equal(t, 0, pages)
+
+	// Appending to the page doesn't overwrite the original slice
+	items, _ = Paginate(sl, 1, 2)
+	_ = append(items, 10)
+	assert.Equal(t, []int{1, 2, 3, 4, 5}, sl)
+}
@@ -0,0 +1,203 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/nodasoft/go-utils/generics"
+)
+
+var (
+	// ErrOutOfRange means that a value doesn't fit the bounds of the target type.
+	ErrOutOfRange = errors.New("value out of range")
+	// ErrPrecisionLoss means that a value is converted with a loss of its fractional part or precision.
+	ErrPrecisionLoss = errors.New("loss of precision")
+	// ErrNaN means that NaN is converted to an integer type.
+	ErrNaN = errors.New("NaN can't be converted to an integer")
+)
+
+// ConvertError describes an element of a slice that can't be converted without a loss.
+type ConvertError struct {
+	Index int   // index of the element in the slice
+	Value any   // the element
+	Err   error // the reason: ErrOutOfRange, ErrPrecisionLoss or ErrNaN
+}
+
+func (e *ConvertError) Error() string {
+	return fmt.Sprintf("convert element %d (%v): %v", e.Index, e.Value, e.Err)
+}
+
+func (e *ConvertError) Unwrap() error {
+	return e.Err
+}
+
+// ConvertNumber changes the type of the value, checking that the result represents the same value.
+// Returns ErrOutOfRange if the value doesn't fit the bounds of R, including infinities converted to integers,
+// ErrPrecisionLoss if a fractional part or precision is lost, and ErrNaN if NaN is converted to an integer.
+// NaN and infinities are converted between floats as is.
+func ConvertNumber[T, R generics.Numeric](v T) (R, error) {
+	return newNumberConverter[T, R]().convert(v)
+}
+
+// ConvertSliceChecked changes the type of slice elements like ConvertSlice does, checking every element like ConvertNumber.
+// On the first element that can't be converted, returns a *ConvertError with its index and value.
+func ConvertSliceChecked[T, R generics.Numeric](s []T) ([]R, error) {
+	c := newNumberConverter[T, R]()
+
+	r := make([]R, 0, len(s))
+	for i, v := range s {
+		rv, err := c.convert(v)
+		if err != nil {
+			return nil, &ConvertError{Index: i, Value: v, Err: err}
+		}
+		r = append(r, rv)
+	}
+
+	return r, nil
+}
+
+// ConvertNumberSaturating changes the type of the value, clamping it to the bounds of R:
+// a value out of range is replaced with the minimum or the maximum value of R.
+// Fractional parts are truncated toward zero and NaN is converted to 0 for integer types.
+// Finite floats that overflow a float type are clamped to its largest finite values, infinities are kept.
+func ConvertNumberSaturating[T, R generics.Numeric](v T) R {
+	return newNumberConverter[T, R]().saturate(v)
+}
+
+// ConvertSliceSaturating changes the type of slice elements, clamping them like ConvertNumberSaturating.
+func ConvertSliceSaturating[T, R generics.Numeric](s []T) []R {
+	c := newNumberConverter[T, R]()
+
+	r := make([]R, 0, len(s))
+	for _, v := range s {
+		r = append(r, c.saturate(v))
+	}
+
+	return r
+}
+
+// numberConverter converts values of type T into type R according to kinds of the types.
+type numberConverter[T, R generics.Numeric] struct {
+	fromFloat bool
+	toFloat   bool
+	// upper bound of integer T: v < fromHi
+	fromHi float64
+	// bounds of R for conversion from floats to integers: lo <= v < hi
+	lo, hi float64
+	// bounds of R
+	minR, maxR R
+}
+
+func newNumberConverter[T, R generics.Numeric]() numberConverter[T, R] {
+	from, to := reflect.TypeFor[T](), reflect.TypeFor[R]()
+	bits := to.Bits()
+
+	c := numberConverter[T, R]{
+		fromFloat: isFloatKind(from.Kind()),
+		toFloat:   isFloatKind(to.Kind()),
+		fromHi:    math.Ldexp(1, from.Bits()),
+	}
+	if !isUintKind(from.Kind()) {
+		c.fromHi = math.Ldexp(1, from.Bits()-1)
+	}
+	switch {
+	case c.toFloat:
+		maxFloat := math.MaxFloat64
+		if bits == 32 {
+			maxFloat = math.MaxFloat32
+		}
+		c.minR, c.maxR = R(-maxFloat), R(maxFloat)
+	case isUintKind(to.Kind()):
+		c.lo, c.hi = 0, math.Ldexp(1, bits)
+		maxU := uint64(math.MaxUint64) >> (64 - bits)
+		c.minR, c.maxR = 0, R(maxU)
+	default:
+		c.lo, c.hi = -math.Ldexp(1, bits-1), math.Ldexp(1, bits-1)
+		minI, maxI := int64(math.MinInt64)>>(64-bits), int64(math.MaxInt64)>>(64-bits)
+		c.minR, c.maxR = R(minI), R(maxI)
+	}
+
+	return c
+}
+
+func (c numberConverter[T, R]) convert(v T) (R, error) {
+	if c.fromFloat && !c.toFloat {
+		f := float64(v)
+		if math.IsNaN(f) {
+			return 0, ErrNaN
+		}
+		trunc := math.Trunc(f)
+		if trunc < c.lo || trunc >= c.hi {
+			return 0, ErrOutOfRange
+		}
+		if trunc != f {
+			return 0, ErrPrecisionLoss
+		}
+
+		return R(v), nil
+	}
+
+	r := R(v)
+	switch {
+	case c.fromFloat && c.toFloat:
+		f, rf := float64(v), float64(r)
+		if math.IsInf(rf, 0) && !math.IsInf(f, 0) {
+			return 0, ErrOutOfRange
+		}
+		if rf != f && !math.IsNaN(f) {
+			return 0, ErrPrecisionLoss
+		}
+	case c.toFloat:
+		// integers are always in range of floats, but may be rounded up to the bound of T,
+		// which can't be converted back
+		if float64(r) >= c.fromHi || T(r) != v {
+			return 0, ErrPrecisionLoss
+		}
+	default:
+		if T(r) != v || (v < 0) != (r < 0) {
+			return 0, ErrOutOfRange
+		}
+	}
+
+	return r, nil
+}
+
+func (c numberConverter[T, R]) saturate(v T) R {
+	if c.fromFloat && !c.toFloat {
+		f := float64(v)
+		switch {
+		case math.IsNaN(f):
+			return 0
+		case f < c.lo:
+			return c.minR
+		case f >= c.hi:
+			return c.maxR
+		}
+
+		return R(v)
+	}
+
+	if _, err := c.convert(v); !errors.Is(err, ErrOutOfRange) {
+		return R(v)
+	}
+	if v < 0 {
+		return c.minR
+	}
+
+	return c.maxR
+}
+
+func isFloatKind(k reflect.Kind) bool {
+	return k == reflect.Float32 || k == reflect.Float64
+}
+
+func isUintKind(k reflect.Kind) bool {
+	switch k {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,128 @@
+package slices
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConvertNumber(t *testing.T) {
+	// Successful conversions
+	u32, err := ConvertNumber[int64, uint32](math.MaxUint32)
+	require.NoError(t, err)
+	assert.Equal(t, uint32(math.MaxUint32), u32)
+
+	i8, err := ConvertNumber[float64, int8](-128)
+	require.NoError(t, err)
+	assert.Equal(t, int8(-128), i8)
+
+	f32, err := ConvertNumber[float64, float32](0.5)
+	require.NoError(t, err)
+	assert.Equal(t, float32(0.5), f32)
+
+	f64, err := ConvertNumber[int64, float64](1 << 53)
+	require.NoError(t, err)
+	assert.Equal(t, float64(1<<53), f64)
+
+	d, err := ConvertNumber[int, time.Duration](5)
+	require.NoError(t, err)
+	assert.Equal(t, 5*time.Nanosecond, d)
+
+	// NaN and infinities between floats
+	f32, err = ConvertNumber[float64, float32](math.NaN())
+	require.NoError(t, err)
+	assert.True(t, math.IsNaN(float64(f32)))
+	f32, err = ConvertNumber[float64, float32](math.Inf(-1))
+	require.NoError(t, err)
+	assert.True(t, math.IsInf(float64(f32), -1))
+}
+
+func TestConvertNumber_Errors(t *testing.T) {
+	cases := []struct {
+		name    string
+		convert func() error
+		err     error
+	}{
+		{"negative to unsigned", func() error { _, err := ConvertNumber[int64, uint32](-1); return err }, ErrOutOfRange},
+		{"negative to uint64", func() error { _, err := ConvertNumber[int8, uint64](-1); return err }, ErrOutOfRange},
+		{"too large unsigned", func() error { _, err := ConvertNumber[int64, uint32](math.MaxUint32 + 1); return err }, ErrOutOfRange},
+		{"too large signed", func() error { _, err := ConvertNumber[uint8, int8](128); return err }, ErrOutOfRange},
+		{"uint64 to int64", func() error { _, err := ConvertNumber[uint64, int64](math.MaxUint64); return err }, ErrOutOfRange},
+		{"too small signed", func() error { _, err := ConvertNumber[int, int16](math.MinInt16 - 1); return err }, ErrOutOfRange},
+		{"float to int8", func() error { _, err := ConvertNumber[float64, int8](128); return err }, ErrOutOfRange},
+		{"float to int64", func() error { _, err := ConvertNumber[float64, int64](1 << 63); return err }, ErrOutOfRange},
+		{"negative float to unsigned", func() error { _, err := ConvertNumber[float32, uint](-1); return err }, ErrOutOfRange},
+		{"infinity to int", func() error { _, err := ConvertNumber[float64, int](math.Inf(1)); return err }, ErrOutOfRange},
+		{"float64 to float32", func() error { _, err := ConvertNumber[float64, float32](1e39); return err }, ErrOutOfRange},
+		{"fraction", func() error { _, err := ConvertNumber[float64, int](1.5); return err }, ErrPrecisionLoss},
+		{"negative fraction to unsigned", func() error { _, err := ConvertNumber[float64, uint](-0.5); return err }, ErrPrecisionLoss},
+		{"float64 precision", func() error { _, err := ConvertNumber[float64, float32](0.1); return err }, ErrPrecisionLoss},
+		{"int64 to float64", func() error { _, err := ConvertNumber[int64, float64](1<<53 + 1); return err }, ErrPrecisionLoss},
+		{"max int64 to float64", func() error { _, err := ConvertNumber[int64, float64](math.MaxInt64); return err }, ErrPrecisionLoss},
+		{"max uint64 to float32", func() error { _, err := ConvertNumber[uint64, float32](math.MaxUint64); return err }, ErrPrecisionLoss},
+		{"NaN to int", func() error { _, err := ConvertNumber[float64, int](math.NaN()); return err }, ErrNaN},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.ErrorIs(t, c.convert(), c.err)
+		})
+	}
+}
+
+func TestConvertSliceChecked(t *testing.T) {
+	r, err := ConvertSliceChecked[int64, uint32]([]int64{1, 2, 3})
+	require.NoError(t, err)
+	assert.Equal(t, []uint32{1, 2, 3}, r)
+
+	r, err = ConvertSliceChecked[int64, uint32]([]int64{1, -2, 1 << 40})
+	assert.Nil(t, r)
+	var convErr *ConvertError
+	require.True(t, errors.As(err, &convErr))
+	assert.Equal(t, 1, convErr.Index)
+	assert.Equal(t, int64(-2), convErr.Value)
+	assert.ErrorIs(t, err, ErrOutOfRange)
+	assert.EqualError(t, err, "convert element 1 (-2): value out of range")
+
+	_, err = ConvertSliceChecked[float64, int]([]float64{1, 2.5})
+	assert.ErrorIs(t, err, ErrPrecisionLoss)
+
+	empty, err := ConvertSliceChecked[int, int8](nil)
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestConvertNumberSaturating(t *testing.T) {
+	assert.Equal(t, uint32(0), ConvertNumberSaturating[int64, uint32](-1))
+	assert.Equal(t, uint32(math.MaxUint32), ConvertNumberSaturating[int64, uint32](1<<40))
+	assert.Equal(t, uint32(7), ConvertNumberSaturating[int64, uint32](7))
+	assert.Equal(t, int8(127), ConvertNumberSaturating[uint64, int8](math.MaxUint64))
+	assert.Equal(t, int8(-128), ConvertNumberSaturating[int, int8](-1000))
+	assert.Equal(t, int64(math.MaxInt64), ConvertNumberSaturating[uint64, int64](math.MaxUint64))
+	assert.Equal(t, uint64(255), ConvertNumberSaturating[uint8, uint64](255))
+
+	// Floats to integers
+	assert.Equal(t, int8(127), ConvertNumberSaturating[float64, int8](1e10))
+	assert.Equal(t, int8(-128), ConvertNumberSaturating[float64, int8](math.Inf(-1)))
+	assert.Equal(t, int64(math.MaxInt64), ConvertNumberSaturating[float64, int64](1e19))
+	assert.Equal(t, uint(0), ConvertNumberSaturating[float64, uint](-0.5))
+	assert.Equal(t, -2, ConvertNumberSaturating[float64, int](-2.9))
+	assert.Equal(t, 0, ConvertNumberSaturating[float64, int](math.NaN()))
+
+	// Floats to floats
+	assert.Equal(t, float32(math.MaxFloat32), ConvertNumberSaturating[float64, float32](1e39))
+	assert.Equal(t, float32(-math.MaxFloat32), ConvertNumberSaturating[float64, float32](-1e39))
+	assert.True(t, math.IsInf(float64(ConvertNumberSaturating[float64, float32](math.Inf(1))), 1))
+	assert.Equal(t, float32(0.1), ConvertNumberSaturating[float64, float32](0.1))
+
+	// Integers to floats
+	assert.Equal(t, float64(math.MaxInt64), ConvertNumberSaturating[int64, float64](math.MaxInt64))
+}
+
+func TestConvertSliceSaturating(t *testing.T) {
+	assert.Equal(t, []uint8{0, 5, 255, 3}, ConvertSliceSaturating[float64, uint8]([]float64{-3, 5, 300, 3.7}))
+	assert.Empty(t, ConvertSliceSaturating[int, uint8](nil))
+}
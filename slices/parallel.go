@@ -0,0 +1,184 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+)
+
+// ParallelOptions configures ParallelMap, ParallelFilter and ParallelForEach.
+type ParallelOptions struct {
+	// Workers is the maximum number of goroutines calling the function at the same time.
+	// Workers <= 0 means runtime.GOMAXPROCS(0).
+	Workers int
+	// CollectErrors makes the functions process all elements and return all errors joined in the input order.
+	// By default, processing stops on the first error, which is returned.
+	CollectErrors bool
+}
+
+// PanicError is the value ParallelMap, ParallelFilter and ParallelForEach panic with in the caller goroutine
+// when the function panics in a worker goroutine.
+type PanicError struct {
+	Value any
+	Stack []byte
+}
+
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic in parallel worker: %v\n%s", e.Value, e.Stack)
+}
+
+// Unwrap returns the panic value if it is an error.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+
+	return err
+}
+
+// ParallelMap returns a slice of fn results for every element of sl, in the order of sl,
+// calling fn concurrently with at most opts.Workers goroutines.
+// The context passed to fn is canceled when processing stops on an error.
+// If ctx is canceled, elements that are not yet started are skipped and ctx.Err() is returned.
+// On error, returns nil, unless opts.CollectErrors is set: then the results of failed and skipped elements are zero.
+// If fn panics, the panic is propagated to the caller as *PanicError.
+func ParallelMap[T, R any](ctx context.Context, sl []T, opts ParallelOptions, fn func(ctx context.Context, v T) (R, error)) ([]R, error) {
+	if len(sl) == 0 {
+		return nil, nil
+	}
+
+	res := make([]R, len(sl))
+	err := parallel(ctx, len(sl), opts, func(ctx context.Context, i int) error {
+		r, err := fn(ctx, sl[i])
+		if err == nil {
+			res[i] = r
+		}
+
+		return err
+	})
+	if err != nil && !opts.CollectErrors {
+		return nil, err
+	}
+
+	return res, err
+}
+
+// ParallelFilter returns a new slice with elements of sl for which pred returns true, in the order of sl,
+// calling pred concurrently with at most opts.Workers goroutines.
+// Errors, cancellation and panics are handled like in ParallelMap; with opts.CollectErrors set,
+// failed and skipped elements are excluded from the result.
+func ParallelFilter[T any](ctx context.Context, sl []T, opts ParallelOptions, pred func(ctx context.Context, v T) (bool, error)) ([]T, error) {
+	keep, err := ParallelMap(ctx, sl, opts, pred)
+	if keep == nil {
+		return nil, err
+	}
+
+	res := make([]T, 0, len(sl))
+	for i, ok := range keep {
+		if ok {
+			res = append(res, sl[i])
+		}
+	}
+
+	return res, err
+}
+
+// ParallelForEach calls fn for every element of sl concurrently with at most opts.Workers goroutines.
+// Errors, cancellation and panics are handled like in ParallelMap.
+func ParallelForEach[T any](ctx context.Context, sl []T, opts ParallelOptions, fn func(ctx context.Context, v T) error) error {
+	if len(sl) == 0 {
+		return nil
+	}
+
+	return parallel(ctx, len(sl), opts, func(ctx context.Context, i int) error {
+		return fn(ctx, sl[i])
+	})
+}
+
+// parallel calls fn for indexes from 0 to n-1 concurrently and waits for all calls to finish.
+func parallel(ctx context.Context, n int, opts ParallelOptions, fn func(ctx context.Context, i int) error) error {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	workers = min(workers, n)
+
+	workerCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg         sync.WaitGroup
+		next       atomic.Int64
+		done       atomic.Int64
+		mu         sync.Mutex
+		firstErr   error
+		firstPanic *PanicError
+		errs       []error
+	)
+	if opts.CollectErrors {
+		errs = make([]error, n)
+	}
+
+	for range workers {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for workerCtx.Err() == nil {
+				i := int(next.Add(1) - 1)
+				if i >= n {
+					return
+				}
+
+				p, err := callRecover(workerCtx, i, fn)
+				done.Add(1)
+				switch {
+				case p != nil:
+					mu.Lock()
+					if firstPanic == nil {
+						firstPanic = p
+					}
+					mu.Unlock()
+					cancel()
+				case err != nil && opts.CollectErrors:
+					errs[i] = err
+				case err != nil:
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+					cancel()
+				}
+			}
+		}()
+	}
+	wg.Wait()
+
+	if firstPanic != nil {
+		panic(firstPanic)
+	}
+	if firstErr != nil {
+		return firstErr
+	}
+	if int(done.Load()) < n && ctx.Err() != nil {
+		if !opts.CollectErrors {
+			return ctx.Err()
+		}
+		errs = append(errs, ctx.Err())
+	}
+
+	return errors.Join(errs...)
+}
+
+// callRecover calls fn and converts its panic into *PanicError.
+func callRecover(ctx context.Context, i int, fn func(ctx context.Context, i int) error) (p *PanicError, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			p = &PanicError{Value: r, Stack: debug.Stack()}
+		}
+	}()
+
+	return nil, fn(ctx, i)
+}
@@ -0,0 +1,195 @@
+package slices
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParallelMap(t *testing.T) {
+	sl := make([]int, 100)
+	for i := range sl {
+		sl[i] = i
+	}
+
+	var running, maxRunning atomic.Int64
+	res, err := ParallelMap(context.Background(), sl, ParallelOptions{Workers: 4}, func(_ context.Context, v int) (string, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for {
+			m := maxRunning.Load()
+			if n <= m || maxRunning.CompareAndSwap(m, n) {
+				break
+			}
+		}
+		time.Sleep(time.Duration(v%3) * time.Millisecond)
+
+		return strconv.Itoa(v), nil
+	})
+	require.NoError(t, err)
+
+	// Order is preserved
+	require.Len(t, res, 100)
+	for i, s := range res {
+		assert.Equal(t, strconv.Itoa(i), s)
+	}
+	// Concurrency is bounded
+	assert.LessOrEqual(t, maxRunning.Load(), int64(4))
+
+	// Empty input
+	res, err = ParallelMap(context.Background(), nil, ParallelOptions{}, func(_ context.Context, v int) (string, error) {
+		return "", nil
+	})
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+}
+
+func TestParallelMap_StopOnError(t *testing.T) {
+	errTest := errors.New("test")
+	sl := make([]int, 1000)
+
+	var calls atomic.Int64
+	res, err := ParallelMap(context.Background(), sl, ParallelOptions{Workers: 2}, func(_ context.Context, v int) (int, error) {
+		if calls.Add(1) == 10 {
+			return 0, errTest
+		}
+
+		return v, nil
+	})
+	assert.ErrorIs(t, err, errTest)
+	assert.Nil(t, res)
+	assert.Less(t, calls.Load(), int64(len(sl)))
+
+	// Context of other workers is canceled
+	var canceled atomic.Bool
+	started := make(chan struct{})
+	err = ParallelForEach(context.Background(), []int{0, 1}, ParallelOptions{Workers: 2}, func(ctx context.Context, v int) error {
+		if v == 0 {
+			<-started
+			return errTest
+		}
+		close(started)
+		select {
+		case <-ctx.Done():
+			canceled.Store(true)
+		case <-time.After(time.Second):
+		}
+
+		return nil
+	})
+	assert.ErrorIs(t, err, errTest)
+	assert.True(t, canceled.Load())
+}
+
+func TestParallelMap_CollectErrors(t *testing.T) {
+	err1, err3 := errors.New("1"), errors.New("3")
+	res, err := ParallelMap(context.Background(), []int{0, 1, 2, 3}, ParallelOptions{CollectErrors: true}, func(_ context.Context, v int) (int, error) {
+		switch v {
+		case 1:
+			return -1, err1
+		case 3:
+			return -1, err3
+		}
+
+		return v * 10, nil
+	})
+	assert.ErrorIs(t, err, err1)
+	assert.ErrorIs(t, err, err3)
+	assert.Equal(t, "1\n3", err.Error())
+	assert.Equal(t, []int{0, 0, 20, 0}, res)
+}
+
+func TestParallelMap_Context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var calls atomic.Int64
+	_, err := ParallelMap(ctx, make([]int, 100), ParallelOptions{Workers: 1}, func(_ context.Context, v int) (int, error) {
+		if calls.Add(1) == 5 {
+			cancel()
+		}
+
+		return v, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, int64(5), calls.Load())
+
+	// Canceled context with collected errors
+	res, err := ParallelMap(ctx, []int{1, 2}, ParallelOptions{CollectErrors: true}, func(_ context.Context, v int) (int, error) {
+		return v, nil
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, []int{0, 0}, res)
+}
+
+func TestParallelMap_Panic(t *testing.T) {
+	errPanic := errors.New("boom")
+
+	defer func() {
+		r := recover()
+		require.NotNil(t, r)
+
+		var p *PanicError
+		require.ErrorAs(t, r.(error), &p)
+		assert.Equal(t, errPanic, p.Value)
+		assert.ErrorIs(t, p, errPanic)
+		assert.NotEmpty(t, p.Stack)
+	}()
+
+	_, _ = ParallelMap(context.Background(), []int{1, 2, 3}, ParallelOptions{Workers: 2}, func(_ context.Context, v int) (int, error) {
+		if v == 2 {
+			panic(errPanic)
+		}
+
+		return v, nil
+	})
+	assert.Fail(t, "panic is not propagated")
+}
+
+func TestParallelFilter(t *testing.T) {
+	even := func(_ context.Context, v int) (bool, error) { return v%2 == 0, nil }
+	res, err := ParallelFilter(context.Background(), []int{1, 2, 3, 4, 5, 6}, ParallelOptions{Workers: 3}, even)
+	assert.NoError(t, err)
+	assert.Equal(t, []int{2, 4, 6}, res)
+
+	res, err = ParallelFilter(context.Background(), []int{}, ParallelOptions{}, even)
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// Failed elements are excluded
+	errTest := errors.New("test")
+	res, err = ParallelFilter(context.Background(), []int{1, 2, 4}, ParallelOptions{CollectErrors: true}, func(_ context.Context, v int) (bool, error) {
+		if v == 2 {
+			return true, errTest
+		}
+
+		return true, nil
+	})
+	assert.ErrorIs(t, err, errTest)
+	assert.Equal(t, []int{1, 4}, res)
+
+	res, err = ParallelFilter(context.Background(), []int{1, 2}, ParallelOptions{}, func(_ context.Context, v int) (bool, error) {
+		return false, errTest
+	})
+	assert.ErrorIs(t, err, errTest)
+	assert.Nil(t, res)
+}
+
+func TestParallelForEach(t *testing.T) {
+	var sum atomic.Int64
+	err := ParallelForEach(context.Background(), []int64{1, 2, 3, 4}, ParallelOptions{Workers: -1}, func(_ context.Context, v int64) error {
+		sum.Add(v)
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), sum.Load())
+
+	assert.NoError(t, ParallelForEach(context.Background(), []int{}, ParallelOptions{}, func(_ context.Context, v int) error {
+		return errors.New("must not be called")
+	}))
+}
@@ -0,0 +1,99 @@
+package slices
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/nodasoft/go-utils/generics"
+)
+
+// ErrEmptyValue is wrapped by ParseError when an empty string is parsed without ParseOptions.SkipEmpty.
+var ErrEmptyValue = errors.New("empty value")
+
+// ParseOptions configures ParseNumbers and ParseDelimited.
+type ParseOptions struct {
+	// TrimSpace trims leading and trailing white space of every string before parsing.
+	TrimSpace bool
+	// SkipEmpty skips empty strings instead of returning an error.
+	SkipEmpty bool
+}
+
+// ParseError describes a string of a slice that can't be parsed as a number.
+type ParseError struct {
+	Index int    // index of the string in the slice
+	Value string // the string
+	Err   error  // the reason, *strconv.NumError or ErrEmptyValue
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("parse element %d %q: %v", e.Index, e.Value, e.Err)
+}
+
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// ParseNumbers parses base-10 strings into numbers of type T: signed and unsigned integers or floats.
+// A value that doesn't fit the width of T is an error wrapping strconv.ErrRange.
+// On the first failed string, returns a *ParseError with its index and value.
+func ParseNumbers[T generics.Numeric](ss []string, opts ParseOptions) ([]T, error) {
+	parse := numberParser[T]()
+
+	res := make([]T, 0, len(ss))
+	for i, s := range ss {
+		if opts.TrimSpace {
+			s = strings.TrimSpace(s)
+		}
+		if s == "" {
+			if opts.SkipEmpty {
+				continue
+			}
+			return nil, &ParseError{Index: i, Value: ss[i], Err: ErrEmptyValue}
+		}
+
+		v, err := parse(s)
+		if err != nil {
+			return nil, &ParseError{Index: i, Value: ss[i], Err: err}
+		}
+		res = append(res, v)
+	}
+
+	return res, nil
+}
+
+// ParseDelimited splits s by sep and parses the parts like ParseNumbers, e.g. query parameters like "ids=1,2,3".
+// Indexes in errors are the positions of the parts. If s is empty, returns nil.
+func ParseDelimited[T generics.Numeric](s, sep string, opts ParseOptions) ([]T, error) {
+	if s == "" {
+		return nil, nil
+	}
+
+	return ParseNumbers[T](strings.Split(s, sep), opts)
+}
+
+// numberParser returns a function parsing a string into T according to the kind and the width of T.
+func numberParser[T generics.Numeric]() func(s string) (T, error) {
+	typ := reflect.TypeFor[T]()
+	bits := typ.Bits()
+
+	switch typ.Kind() {
+	case reflect.Float32, reflect.Float64:
+		return func(s string) (T, error) {
+			v, err := strconv.ParseFloat(s, bits)
+			return T(v), err
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return func(s string) (T, error) {
+			v, err := strconv.ParseUint(s, 10, bits)
+			return T(v), err
+		}
+	default:
+		return func(s string) (T, error) {
+			v, err := strconv.ParseInt(s, 10, bits)
+			return T(v), err
+		}
+	}
+}
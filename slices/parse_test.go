@@ -0,0 +1,134 @@
+package slices
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNumbers(t *testing.T) {
+	ints, err := ParseNumbers[int]([]string{"1", "-2", "30"}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, -2, 30}, ints)
+
+	uints, err := ParseNumbers[uint16]([]string{"0", "65535"}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint16{0, 65535}, uints)
+
+	floats, err := ParseNumbers[float64]([]string{"1.5", "-2.25", "3"}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.5, -2.25, 3}, floats)
+
+	floats32, err := ParseNumbers[float32]([]string{"0.1"}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []float32{0.1}, floats32)
+
+	durations, err := ParseNumbers[time.Duration]([]string{"1000"}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []time.Duration{time.Microsecond}, durations)
+
+	empty, err := ParseNumbers[int]([]string{}, ParseOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestParseNumbers_Errors(t *testing.T) {
+	cases := []struct {
+		name  string
+		parse func() error
+		index int
+		value string
+		err   error
+	}{
+		{"int8 overflow", func() error {
+			_, err := ParseNumbers[int8]([]string{"1", "128"}, ParseOptions{})
+			return err
+		}, 1, "128", strconv.ErrRange},
+		{"uint8 overflow", func() error {
+			_, err := ParseNumbers[uint8]([]string{"256"}, ParseOptions{})
+			return err
+		}, 0, "256", strconv.ErrRange},
+		{"negative unsigned", func() error {
+			_, err := ParseNumbers[uint]([]string{"1", "2", "-3"}, ParseOptions{})
+			return err
+		}, 2, "-3", strconv.ErrSyntax},
+		{"float32 overflow", func() error {
+			_, err := ParseNumbers[float32]([]string{"1e39"}, ParseOptions{})
+			return err
+		}, 0, "1e39", strconv.ErrRange},
+		{"fraction into integer", func() error {
+			_, err := ParseNumbers[int]([]string{"1.5"}, ParseOptions{})
+			return err
+		}, 0, "1.5", strconv.ErrSyntax},
+		{"empty value", func() error {
+			_, err := ParseNumbers[int]([]string{"1", ""}, ParseOptions{})
+			return err
+		}, 1, "", ErrEmptyValue},
+		{"white space is not trimmed", func() error {
+			_, err := ParseNumbers[int]([]string{" 1"}, ParseOptions{})
+			return err
+		}, 0, " 1", strconv.ErrSyntax},
+		{"white space only", func() error {
+			_, err := ParseNumbers[int]([]string{" "}, ParseOptions{TrimSpace: true})
+			return err
+		}, 0, " ", ErrEmptyValue},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.parse()
+
+			var parseErr *ParseError
+			require.True(t, errors.As(err, &parseErr))
+			assert.Equal(t, c.index, parseErr.Index)
+			assert.Equal(t, c.value, parseErr.Value)
+			assert.ErrorIs(t, err, c.err)
+		})
+	}
+
+	_, err := ParseNumbers[int]([]string{"1", "x"}, ParseOptions{})
+	assert.EqualError(t, err, `parse element 1 "x": strconv.ParseInt: parsing "x": invalid syntax`)
+}
+
+func TestParseNumbers_Options(t *testing.T) {
+	ss := []string{" 1", "", "2 ", " "}
+
+	res, err := ParseNumbers[int](ss, ParseOptions{TrimSpace: true, SkipEmpty: true})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, res)
+
+	res, err = ParseNumbers[int]([]string{"1", "", "2"}, ParseOptions{SkipEmpty: true})
+	require.NoError(t, err)
+	assert.Equal(t, []int{1, 2}, res)
+
+	// White space only is not empty without trimming
+	_, err = ParseNumbers[int]([]string{" "}, ParseOptions{SkipEmpty: true})
+	assert.ErrorIs(t, err, strconv.ErrSyntax)
+}
+
+func TestParseDelimited(t *testing.T) {
+	ids, err := ParseDelimited[int64]("1,2,-3", ",", ParseOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2, -3}, ids)
+
+	prices, err := ParseDelimited[float64]("1.5; 2.25", ";", ParseOptions{TrimSpace: true})
+	require.NoError(t, err)
+	assert.Equal(t, []float64{1.5, 2.25}, prices)
+
+	ids, err = ParseDelimited[int64]("", ",", ParseOptions{})
+	require.NoError(t, err)
+	assert.Nil(t, ids)
+
+	ids, err = ParseDelimited[int64]("1,,2,", ",", ParseOptions{SkipEmpty: true})
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1, 2}, ids)
+
+	_, err = ParseDelimited[uint32]("1,2,x", ",", ParseOptions{})
+	var parseErr *ParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, 2, parseErr.Index)
+	assert.Equal(t, "x", parseErr.Value)
+}
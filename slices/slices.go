@@ -1,6 +1,7 @@
 package slices
 
 import (
+	"cmp"
 	"strconv"
 	"strings"
 
@@ -8,6 +9,7 @@ import (
 )
 
 // ConvertSlice changes the type of slice elements.
+// Values out of range of R are wrapped or truncated, use ConvertSliceChecked or ConvertSliceSaturating to avoid it.
 // Example: newSlice := slices.ConvertSlice[int32, uint]([]int32{1,2,3})
 func ConvertSlice[T, R generics.Numeric](s []T) []R {
 	r := make([]R, 0, len(s))
@@ -59,40 +61,110 @@ func Unique[T comparable](sl []T) []T {
 	return sl[:newSize]
 }
 
-// Union merges two slices, excluding duplicates.
-func Union[T comparable](sl1, sl2 []T) []T {
-	uintsMap := make(map[T]struct{})
+// FilterNilCopy returns a new slice without empty values - 0, "", etc.
+// Unlike FilterNil, the original slice is not modified. If there are no non-empty values, returns nil.
+func FilterNilCopy[T comparable](sl []T) []T {
+	var nilVar T
+	var res []T
 
-	for _, v := range sl1 {
-		uintsMap[v] = struct{}{}
+	for _, v := range sl {
+		if v != nilVar {
+			res = append(res, v)
+		}
 	}
 
-	for _, v := range sl2 {
-		uintsMap[v] = struct{}{}
+	return res
+}
+
+// UniqueCopy returns a new slice without duplicates, keeping the first occurrences in the original order.
+// Unlike Unique, the original slice is not modified. If the slice is empty, returns nil.
+func UniqueCopy[T comparable](sl []T) []T {
+	return UniqueBy(sl, func(v T) T { return v })
+}
+
+// UniqueBy returns a new slice without elements with duplicate keys returned by keyFn,
+// keeping the first occurrences in the original order. If the slice is empty, returns nil.
+func UniqueBy[T any, K comparable](sl []T, keyFn func(T) K) []T {
+	if len(sl) == 0 {
+		return nil
+	}
+
+	seen := make(map[K]struct{}, len(sl))
+	res := make([]T, 0, len(sl))
+
+	for _, v := range sl {
+		k := keyFn(v)
+		if _, ok := seen[k]; !ok {
+			seen[k] = struct{}{}
+			res = append(res, v)
+		}
+	}
+
+	return res
+}
+
+// UniqueFunc returns a new slice without elements equal by eq to preceding ones,
+// keeping the first occurrences in the original order. It is intended for non-comparable elements
+// and takes O(n*m) time, where m is the number of unique elements. If the slice is empty, returns nil.
+func UniqueFunc[T any](sl []T, eq func(a, b T) bool) []T {
+	if len(sl) == 0 {
+		return nil
+	}
+
+	res := make([]T, 0, len(sl))
+
+outer:
+	for _, v := range sl {
+		for _, u := range res {
+			if eq(u, v) {
+				continue outer
+			}
+		}
+		res = append(res, v)
+	}
+
+	return res
+}
+
+// Union merges two slices, excluding duplicates.
+// Elements are in the order of their first appearance in sl1, then in sl2.
+// If both slices are empty, returns nil.
+func Union[T comparable](sl1, sl2 []T) []T {
+	if len(sl1) == 0 && len(sl2) == 0 {
+		return nil
 	}
 
-	result := make([]T, 0, len(uintsMap))
+	seen := make(map[T]struct{}, len(sl1)+len(sl2))
+	res := make([]T, 0, len(sl1)+len(sl2))
 
-	for v := range uintsMap {
-		result = append(result, v)
+	for _, sl := range [][]T{sl1, sl2} {
+		for _, v := range sl {
+			if _, ok := seen[v]; !ok {
+				seen[v] = struct{}{}
+				res = append(res, v)
+			}
+		}
 	}
 
-	return result
+	return res
 }
 
-// Cross returns a slice with values present in both slices.
+// Cross returns a slice with values of sl1 present in sl2, in the order of sl1.
+// Duplicates of sl1 are kept. If there are no such values, returns nil.
 func Cross[T comparable](sl1, sl2 []T) []T {
 	if len(sl1) == 0 || len(sl2) == 0 {
 		return nil
 	}
 
-	res := make([]T, 0, len(sl1))
-	for _, v1 := range sl1 {
-		for _, v2 := range sl2 {
-			if v1 == v2 {
-				res = append(res, v1)
-				break
-			}
+	set := make(map[T]struct{}, len(sl2))
+	for _, v := range sl2 {
+		set[v] = struct{}{}
+	}
+
+	var res []T
+	for _, v := range sl1 {
+		if _, ok := set[v]; ok {
+			res = append(res, v)
 		}
 	}
 
@@ -164,73 +236,73 @@ func GroupBy[K comparable, V any](s []V, keyFn func(V) K) map[K][]V {
 	return m
 }
 
-// SliceDiff returns a slice that contains elements present in the first slice but absent in the others.
+// SliceDiff returns a slice with unique elements present in the first slice but absent in the others,
+// in the order of their first appearance in the first slice. If there are no such elements, returns nil.
 func SliceDiff[T comparable](slices ...[]T) []T {
-	if len(slices) == 1 {
-		return slices[0]
+	if len(slices) == 0 || len(slices[0]) == 0 {
+		return nil
 	}
 
 	mainSl := slices[0]
 
-	excludeValues := make(map[T]struct{})
-	for i := 1; i < len(slices); i++ {
-		sl := slices[i]
+	// values of the other slices are marked as seen, so they are excluded with duplicates of the first slice
+	seen := make(map[T]struct{})
+	for _, sl := range slices[1:] {
 		for _, v := range sl {
-			excludeValues[v] = struct{}{}
+			seen[v] = struct{}{}
 		}
 	}
 
-	res := make([]T, 0, len(mainSl))
+	var res []T
 	for _, v := range mainSl {
-		if _, ok := excludeValues[v]; !ok {
+		if _, ok := seen[v]; !ok {
+			seen[v] = struct{}{}
 			res = append(res, v)
 		}
 	}
 
-	return Unique(res)
+	return res
 }
 
-// SliceIntersect returns a slice with unique values present in all provided slices.
+// SliceIntersect returns a slice with unique values present in all provided slices,
+// in the order of their first appearance in the first slice. If there are no such values, returns nil.
 func SliceIntersect[T comparable](slices ...[]T) []T {
-	tmpValuesMap := make(map[int]map[T]struct{}, len(slices))
+	if len(slices) == 0 {
+		return nil
+	}
 
-	for i := 0; i < len(slices); i++ {
-		sl := slices[i]
+	// number of slices containing the value, counted only for values of the first slice
+	counts := make(map[T]int, len(slices[0]))
+	for _, v := range slices[0] {
+		counts[v] = 1
+	}
+
+	for i, sl := range slices[1:] {
 		if len(sl) == 0 {
-			return make([]T, 0) // empty slice encountered - further processing is pointless
+			return nil // empty slice encountered - further processing is pointless
 		}
 
-		tmpValuesMap[i] = make(map[T]struct{}, len(sl))
-		for _, el := range sl {
-			tmpValuesMap[i][el] = struct{}{}
+		for _, v := range sl {
+			if counts[v] == i+1 { // present in all previous slices and not counted for this one yet
+				counts[v]++
+			}
 		}
 	}
 
-	result := make([]T, 0, (len(slices[0])+len(slices[1]))/2) // len - middle length of two slices
-
-	// iterate over all slices
-	for _, sl := range slices {
-		// iterate over all elements
-		for _, el := range sl {
-			existInAll := true
-			for _, mm := range tmpValuesMap {
-				if _, ok := mm[el]; !ok {
-					existInAll = false
-					break
-				}
-			}
-
-			if existInAll {
-				result = append(result, el)
-			}
+	var res []T
+	for _, v := range slices[0] {
+		if counts[v] == len(slices) {
+			res = append(res, v)
+			counts[v] = 0 // exclude duplicates
 		}
 	}
 
-	return Unique(result)
+	return res
 }
 
 // Max returns the maximum value from the provided values.
-func Max[T generics.Numeric](n []T) T {
+// Panics if the slice is empty, use SafeMax for possibly empty slices.
+func Max[T cmp.Ordered](n []T) T {
 	m := n[0]
 	for i := 1; i < len(n); i++ {
 		if n[i] > m {
@@ -242,7 +314,8 @@ func Max[T generics.Numeric](n []T) T {
 }
 
 // Min returns the minimum value from the provided values.
-func Min[T generics.Numeric](n []T) T {
+// Panics if the slice is empty, use SafeMin for possibly empty slices.
+func Min[T cmp.Ordered](n []T) T {
 	m := n[0]
 	for i := 1; i < len(n); i++ {
 		if n[i] < m {
@@ -263,6 +336,82 @@ func Sum[T generics.Numeric](n []T) T {
 	return sum
 }
 
+// SafeMax returns the maximum value from the provided values.
+// If the slice is empty, returns the zero value and false.
+func SafeMax[T cmp.Ordered](n []T) (T, bool) {
+	i := ArgMax(n)
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+
+	return n[i], true
+}
+
+// SafeMin returns the minimum value from the provided values.
+// If the slice is empty, returns the zero value and false.
+func SafeMin[T cmp.Ordered](n []T) (T, bool) {
+	i := ArgMin(n)
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+
+	return n[i], true
+}
+
+// ArgMax returns the index of the first maximum value. If the slice is empty, returns -1.
+func ArgMax[T cmp.Ordered](n []T) int {
+	return argBest(n, func(v T) T { return v }, 1)
+}
+
+// ArgMin returns the index of the first minimum value. If the slice is empty, returns -1.
+func ArgMin[T cmp.Ordered](n []T) int {
+	return argBest(n, func(v T) T { return v }, -1)
+}
+
+// MaxBy returns the first element with the maximum key returned by keyFn.
+// If the slice is empty, returns the zero value and false.
+func MaxBy[T any, K cmp.Ordered](sl []T, keyFn func(T) K) (T, bool) {
+	i := argBest(sl, keyFn, 1)
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+
+	return sl[i], true
+}
+
+// MinBy returns the first element with the minimum key returned by keyFn.
+// If the slice is empty, returns the zero value and false.
+func MinBy[T any, K cmp.Ordered](sl []T, keyFn func(T) K) (T, bool) {
+	i := argBest(sl, keyFn, -1)
+	if i < 0 {
+		var zero T
+		return zero, false
+	}
+
+	return sl[i], true
+}
+
+// argBest returns the index of the first element with the maximum (sign > 0) or the minimum (sign < 0) key,
+// -1 if the slice is empty. keyFn is called once per element.
+func argBest[T any, K cmp.Ordered](sl []T, keyFn func(T) K, sign int) int {
+	if len(sl) == 0 {
+		return -1
+	}
+
+	best, bestKey := 0, keyFn(sl[0])
+	for i := 1; i < len(sl); i++ {
+		k := keyFn(sl[i])
+		if (sign > 0 && k > bestKey) || (sign < 0 && k < bestKey) {
+			best, bestKey = i, k
+		}
+	}
+
+	return best
+}
+
 type iUints interface {
 	uint | uint8 | uint16 | uint32 | uint64
 }
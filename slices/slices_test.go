@@ -2,6 +2,7 @@ package slices
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -18,14 +19,88 @@ func TestUnique(t *testing.T) {
 	assert.Equal(t, []uint{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}, r)
 }
 
+func TestFilterNilCopy(t *testing.T) {
+	sl := []string{"", "a", "", "b", "c", ""}
+	r := FilterNilCopy(sl)
+
+	assert.Equal(t, []string{"a", "b", "c"}, r)
+	assert.Equal(t, []string{"", "a", "", "b", "c", ""}, sl)
+	assert.Nil(t, FilterNilCopy([]int{0, 0}))
+	assert.Nil(t, FilterNilCopy[int](nil))
+}
+
+func TestUniqueCopy(t *testing.T) {
+	sl := []uint{3, 1, 3, 2, 1, 0, 2}
+	r := UniqueCopy(sl)
+
+	assert.Equal(t, []uint{3, 1, 2, 0}, r)
+	assert.Equal(t, []uint{3, 1, 3, 2, 1, 0, 2}, sl)
+	assert.Nil(t, UniqueCopy([]uint{}))
+
+	// Result doesn't share memory with the original slice
+	r = UniqueCopy(sl[:2])
+	r[0] = 10
+	assert.Equal(t, uint(3), sl[0])
+}
+
+func TestUniqueBy(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+	users := []user{{1, "a"}, {2, "b"}, {1, "c"}, {3, "a"}}
+
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}, {3, "a"}}, UniqueBy(users, func(u user) int { return u.ID }))
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}, {1, "c"}}, UniqueBy(users, func(u user) string { return u.Name }))
+	assert.Equal(t, []user{{1, "a"}, {2, "b"}, {1, "c"}, {3, "a"}}, users)
+	assert.Nil(t, UniqueBy(nil, func(u user) int { return u.ID }))
+}
+
+func TestUniqueFunc(t *testing.T) {
+	sl := [][]int{{1, 2}, {3}, {1, 2}, {}, {3}, nil}
+	eq := func(a, b []int) bool {
+		if len(a) != len(b) {
+			return false
+		}
+		for i := range a {
+			if a[i] != b[i] {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	assert.Equal(t, [][]int{{1, 2}, {3}, {}}, UniqueFunc(sl, eq))
+	assert.Len(t, sl, 6)
+	assert.Nil(t, UniqueFunc(nil, eq))
+}
+
 func TestUnion(t *testing.T) {
 	r := Union([]uint{1, 2, 3, 4}, []uint{3, 4, 5})
 	assert.ElementsMatch(t, []uint{1, 2, 3, 4, 5}, r)
+
+	// Order of the first appearance
+	assert.Equal(t, []uint{4, 1, 3, 5, 2}, Union([]uint{4, 1, 4, 3}, []uint{5, 3, 2, 5}))
+	assert.Equal(t, []uint{1, 2}, Union(nil, []uint{1, 2, 1}))
+	assert.Equal(t, []uint{1, 2}, Union([]uint{1, 2, 1}, nil))
+
+	// Empty result
+	assert.Nil(t, Union[uint](nil, nil))
+	assert.Nil(t, Union([]uint{}, []uint{}))
 }
 
 func TestCross(t *testing.T) {
 	r := Cross([]uint{1, 2, 3}, []uint{2, 3, 4})
 	assert.Equal(t, []uint{2, 3}, r)
+
+	// Order and duplicates of the first slice
+	assert.Equal(t, []uint{3, 2, 3}, Cross([]uint{3, 1, 2, 3}, []uint{2, 3, 2}))
+
+	// Empty result
+	assert.Nil(t, Cross([]uint{1, 2}, []uint{3}))
+	assert.Nil(t, Cross(nil, []uint{3}))
+	assert.Nil(t, Cross([]uint{1}, []uint{}))
 }
 
 func TestIsEqual(t *testing.T) {
@@ -89,11 +164,34 @@ func TestGroupBy_EmptySlice(t *testing.T) {
 func TestSliceDiff(t *testing.T) {
 	r := SliceDiff([]uint{1, 2, 3, 4}, []uint{2, 3})
 	assert.Equal(t, []uint{1, 4}, r)
+
+	// Order of the first appearance, duplicates are excluded
+	assert.Equal(t, []uint{5, 1, 4}, SliceDiff([]uint{5, 2, 1, 5, 4, 1}, []uint{2}, []uint{3}))
+	assert.Equal(t, []uint{2, 1}, SliceDiff([]uint{2, 1, 2}))
+	assert.Equal(t, []uint{2, 1}, SliceDiff([]uint{2, 1}, nil))
+
+	// Empty result
+	assert.Nil(t, SliceDiff([]uint{1, 2}, []uint{2, 1}))
+	assert.Nil(t, SliceDiff([]uint{}, []uint{1}))
+	assert.Nil(t, SliceDiff[uint]())
 }
 
 func TestSliceIntersect(t *testing.T) {
 	r := SliceIntersect([]uint{1, 2, 3}, []uint{2, 3}, []uint{3, 4})
 	assert.Equal(t, []uint{3}, r)
+
+	// Order of the first appearance in the first slice, duplicates are excluded
+	assert.Equal(t, []uint{4, 2, 3}, SliceIntersect([]uint{4, 1, 2, 4, 3}, []uint{3, 2, 2, 4}, []uint{2, 3, 4, 4}))
+	assert.Equal(t, []uint{2, 1}, SliceIntersect([]uint{2, 1, 2}))
+
+	// Value repeated in one slice is not counted for another
+	assert.Nil(t, SliceIntersect([]uint{1}, []uint{1, 1}, []uint{2}))
+
+	// Empty result
+	assert.Nil(t, SliceIntersect([]uint{1, 2}, []uint{3}))
+	assert.Nil(t, SliceIntersect([]uint{1, 2}, []uint{}, []uint{1}))
+	assert.Nil(t, SliceIntersect([]uint{}, []uint{1}))
+	assert.Nil(t, SliceIntersect[uint]())
 }
 
 func TestMax(t *testing.T) {
@@ -105,6 +203,9 @@ func TestMax(t *testing.T) {
 	int8Val := Max([]int8{-2, 3, 15, 28, 4})
 	var expInt8 int8 = 28
 	assert.Equal(t, expInt8, int8Val)
+	assert.Equal(t, "pear", Max([]string{"apple", "pear", "banana"}))
+	assert.Equal(t, time.Minute, Max([]time.Duration{time.Second, time.Minute}))
+	assert.Panics(t, func() { Max([]int{}) })
 }
 
 func TestMin(t *testing.T) {
@@ -116,6 +217,8 @@ func TestMin(t *testing.T) {
 	minInt8 := Min([]int8{4, -2, 3, 15, 28, 4})
 	var expInt8 int8 = -2
 	assert.Equal(t, expInt8, minInt8)
+	assert.Equal(t, "apple", Min([]string{"pear", "apple", "banana"}))
+	assert.Panics(t, func() { Min([]int{}) })
 }
 
 func TestSum(t *testing.T) {
@@ -127,6 +230,67 @@ func TestSum(t *testing.T) {
 	sumInt8 := Sum([]int8{4, -2, 3, 15, 28, 4})
 	var expInt8 int8 = 52
 	assert.Equal(t, expInt8, sumInt8)
+	assert.Equal(t, 2*time.Second, Sum([]time.Duration{time.Second, time.Second}))
+	assert.Equal(t, 0, Sum([]int{}))
+}
+
+func TestSafeMaxMin(t *testing.T) {
+	v, ok := SafeMax([]int{-2, 28, 3, 28})
+	assert.True(t, v == 28 && ok)
+	v, ok = SafeMin([]int{1, -2, 3, -2})
+	assert.True(t, v == -2 && ok)
+
+	s, ok := SafeMax([]string{"b", "c", "a"})
+	assert.True(t, s == "c" && ok)
+	d, ok := SafeMin([]time.Duration{time.Minute, time.Second})
+	assert.True(t, d == time.Second && ok)
+
+	v, ok = SafeMax([]int{})
+	assert.True(t, v == 0 && !ok)
+	s, ok = SafeMin[string](nil)
+	assert.True(t, s == "" && !ok)
+}
+
+func TestArgMaxMin(t *testing.T) {
+	// The first of equal values
+	assert.Equal(t, 1, ArgMax([]int{-2, 28, 3, 28}))
+	assert.Equal(t, 1, ArgMin([]int{1, -2, 3, -2}))
+	assert.Equal(t, 0, ArgMax([]float64{1.5}))
+	assert.Equal(t, 2, ArgMin([]string{"b", "c", "a"}))
+
+	assert.Equal(t, -1, ArgMax([]int{}))
+	assert.Equal(t, -1, ArgMin[int](nil))
+}
+
+func TestMaxMinBy(t *testing.T) {
+	type product struct {
+		Name  string
+		Price float64
+	}
+	products := []product{{"a", 10}, {"b", 30}, {"c", 5}, {"d", 30}, {"e", 5}}
+	price := func(p product) float64 { return p.Price }
+
+	p, ok := MaxBy(products, price)
+	assert.True(t, ok)
+	assert.Equal(t, product{"b", 30}, p)
+
+	p, ok = MinBy(products, price)
+	assert.True(t, ok)
+	assert.Equal(t, product{"c", 5}, p)
+
+	// keyFn is called once per element
+	calls := 0
+	_, _ = MaxBy(products, func(p product) string {
+		calls++
+		return p.Name
+	})
+	assert.Equal(t, len(products), calls)
+
+	p, ok = MaxBy(nil, price)
+	assert.False(t, ok)
+	assert.Equal(t, product{}, p)
+	_, ok = MinBy([]product{}, price)
+	assert.False(t, ok)
 }
 
 func BenchmarkFilterNil(b *testing.B) {
@@ -0,0 +1,125 @@
+package slices
+
+import (
+	"cmp"
+	stdslices "slices"
+)
+
+// SortOrder is the direction of sorting by a key.
+type SortOrder int
+
+const (
+	// Asc sorts keys in ascending order.
+	Asc SortOrder = iota
+	// Desc sorts keys in descending order.
+	Desc
+)
+
+// NullsOrder defines where ByNullable puts elements without a key, regardless of SortOrder.
+type NullsOrder int
+
+const (
+	// NullsLast puts elements without a key after the others.
+	NullsLast NullsOrder = iota
+	// NullsFirst puts elements without a key before the others.
+	NullsFirst
+)
+
+// Comparator compares two elements and returns a negative number if a < b, a positive number if a > b and 0 otherwise.
+// Comparators are built by By and ByNullable and combined with Then, e.g. to sort by price, then by name:
+//
+//	slices.SortWith(products, slices.By(price, slices.Asc).Then(slices.By(name, slices.Desc)))
+type Comparator[T any] func(a, b T) int
+
+// By returns a comparator of keys returned by keyFn in the given order.
+func By[T any, K cmp.Ordered](keyFn func(T) K, order SortOrder) Comparator[T] {
+	c := func(a, b T) int {
+		return cmp.Compare(keyFn(a), keyFn(b))
+	}
+	if order == Desc {
+		return Comparator[T](c).Reverse()
+	}
+
+	return c
+}
+
+// ByNullable returns a comparator of keys returned by keyFn in the given order.
+// Elements for which keyFn returns false have no key and are put according to nulls.
+func ByNullable[T any, K cmp.Ordered](keyFn func(T) (K, bool), order SortOrder, nulls NullsOrder) Comparator[T] {
+	nullFirst := -1
+	if nulls == NullsLast {
+		nullFirst = 1
+	}
+
+	return func(a, b T) int {
+		ka, okA := keyFn(a)
+		kb, okB := keyFn(b)
+		switch {
+		case !okA && !okB:
+			return 0
+		case !okA:
+			return nullFirst
+		case !okB:
+			return -nullFirst
+		case order == Desc:
+			return cmp.Compare(kb, ka)
+		default:
+			return cmp.Compare(ka, kb)
+		}
+	}
+}
+
+// Then returns a comparator that compares elements by c and, if they are equal, by next.
+func (c Comparator[T]) Then(next Comparator[T]) Comparator[T] {
+	return func(a, b T) int {
+		if r := c(a, b); r != 0 {
+			return r
+		}
+
+		return next(a, b)
+	}
+}
+
+// Reverse returns a comparator with the reversed order of c.
+func (c Comparator[T]) Reverse() Comparator[T] {
+	return func(a, b T) int {
+		return c(b, a)
+	}
+}
+
+// SortWith sorts the slice in place by the comparator. The sort is stable: equal elements keep their order.
+func SortWith[T any](sl []T, c Comparator[T]) {
+	stdslices.SortStableFunc(sl, c)
+}
+
+// SortBy sorts the slice in place in ascending order of keys returned by keyFn.
+// The sort is stable: elements with equal keys keep their order.
+func SortBy[T any, K cmp.Ordered](sl []T, keyFn func(T) K) {
+	SortWith(sl, By(keyFn, Asc))
+}
+
+// IsSortedBy checks if the slice is sorted in ascending order of keys returned by keyFn.
+func IsSortedBy[T any, K cmp.Ordered](sl []T, keyFn func(T) K) bool {
+	return stdslices.IsSortedFunc(sl, By(keyFn, Asc))
+}
+
+// SortByReference sorts the slice in place so that keys returned by keyFn follow the order of keys in order,
+// e.g. to restore the order of IDs after loading entities from a database.
+// Elements with keys absent in order are put last. Elements with equal positions keep their order.
+// If a key is repeated in order, its first position is used.
+func SortByReference[T any, K comparable](sl []T, order []K, keyFn func(T) K) {
+	positions := make(map[K]int, len(order))
+	for i, k := range order {
+		if _, ok := positions[k]; !ok {
+			positions[k] = i
+		}
+	}
+
+	SortBy(sl, func(v T) int {
+		if i, ok := positions[keyFn(v)]; ok {
+			return i
+		}
+
+		return len(order)
+	})
+}
@@ -0,0 +1,101 @@
+package slices
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type sortProduct struct {
+	Name  string
+	Price float64
+	Stock *int
+}
+
+func sortProductNames(products []sortProduct) []string {
+	names := make([]string, 0, len(products))
+	for _, p := range products {
+		names = append(names, p.Name)
+	}
+
+	return names
+}
+
+func TestSortBy(t *testing.T) {
+	products := []sortProduct{{Name: "c", Price: 3}, {Name: "a", Price: 1}, {Name: "b", Price: 3}, {Name: "d", Price: 2}}
+	price := func(p sortProduct) float64 { return p.Price }
+
+	assert.False(t, IsSortedBy(products, price))
+	SortBy(products, price)
+	// Stable for equal keys
+	assert.Equal(t, []string{"a", "d", "c", "b"}, sortProductNames(products))
+	assert.True(t, IsSortedBy(products, price))
+
+	assert.True(t, IsSortedBy([]sortProduct{}, price))
+	assert.NotPanics(t, func() { SortBy(nil, price) })
+}
+
+func TestComparator(t *testing.T) {
+	products := []sortProduct{{Name: "a", Price: 2}, {Name: "b", Price: 1}, {Name: "c", Price: 2}, {Name: "d", Price: 1}}
+	price := func(p sortProduct) float64 { return p.Price }
+	name := func(p sortProduct) string { return p.Name }
+
+	SortWith(products, By(price, Asc).Then(By(name, Desc)))
+	assert.Equal(t, []string{"d", "b", "c", "a"}, sortProductNames(products))
+
+	SortWith(products, By(price, Desc).Then(By(name, Asc)))
+	assert.Equal(t, []string{"a", "c", "b", "d"}, sortProductNames(products))
+
+	SortWith(products, By(price, Desc).Then(By(name, Asc)).Reverse())
+	assert.Equal(t, []string{"d", "b", "c", "a"}, sortProductNames(products))
+
+	c := By(name, Asc)
+	assert.Negative(t, c(products[3], products[0]))
+	assert.Zero(t, c(products[0], products[0]))
+}
+
+func TestByNullable(t *testing.T) {
+	one, two := 1, 2
+	products := []sortProduct{{Name: "a"}, {Name: "b", Stock: &two}, {Name: "c"}, {Name: "d", Stock: &one}}
+	stock := func(p sortProduct) (int, bool) {
+		if p.Stock == nil {
+			return 0, false
+		}
+
+		return *p.Stock, true
+	}
+
+	cases := []struct {
+		order    SortOrder
+		nulls    NullsOrder
+		expected []string
+	}{
+		{Asc, NullsLast, []string{"d", "b", "a", "c"}},
+		{Asc, NullsFirst, []string{"a", "c", "d", "b"}},
+		{Desc, NullsLast, []string{"b", "d", "a", "c"}},
+		{Desc, NullsFirst, []string{"a", "c", "b", "d"}},
+	}
+	for _, c := range cases {
+		sl := append([]sortProduct(nil), products...)
+		SortWith(sl, ByNullable(stock, c.order, c.nulls))
+		assert.Equal(t, c.expected, sortProductNames(sl), "order=%d, nulls=%d", c.order, c.nulls)
+	}
+
+	// Nulls are equal to each other
+	sl := append([]sortProduct(nil), products...)
+	SortWith(sl, ByNullable(stock, Asc, NullsFirst).Then(By(func(p sortProduct) string { return p.Name }, Desc)))
+	assert.Equal(t, []string{"c", "a", "d", "b"}, sortProductNames(sl))
+}
+
+func TestSortByReference(t *testing.T) {
+	products := []sortProduct{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}
+	name := func(p sortProduct) string { return p.Name }
+
+	SortByReference(products, []string{"d", "x", "b", "a", "d"}, name)
+	// Unknown keys are last in the original order
+	assert.Equal(t, []string{"d", "b", "a", "c", "e"}, sortProductNames(products))
+
+	ids := []int{3, 1, 2}
+	SortByReference(ids, nil, func(v int) int { return v })
+	assert.Equal(t, []int{3, 1, 2}, ids)
+}
@@ -0,0 +1,144 @@
+package slices
+
+import (
+	"errors"
+	"math"
+	"sort"
+
+	"github.com/nodasoft/go-utils/generics"
+)
+
+var (
+	// ErrEmptySlice is returned by functions that have no result for an empty slice.
+	ErrEmptySlice = errors.New("empty slice")
+	// ErrInvalidPercentile is returned by Percentile if the percentile is out of the range [0, 100].
+	ErrInvalidPercentile = errors.New("percentile must be in range [0, 100]")
+)
+
+// Mean returns the arithmetic mean of the values.
+// Values are accumulated as float64 with a running mean, so integer values don't overflow.
+// Returns ErrEmptySlice if the slice is empty.
+func Mean[T generics.Numeric](n []T) (float64, error) {
+	if len(n) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	mean, _ := meanVariance(n)
+
+	return mean, nil
+}
+
+// Median returns the middle value of the sorted values, or the mean of the two middle values
+// if the number of values is even. The original slice is not modified.
+// Returns ErrEmptySlice if the slice is empty.
+func Median[T generics.Numeric](n []T) (float64, error) {
+	return Percentile(n, 50)
+}
+
+// Mode returns the most frequent value. If several values are equally frequent, returns the first of them in the slice.
+// Returns ErrEmptySlice if the slice is empty.
+func Mode[T generics.Numeric](n []T) (T, error) {
+	if len(n) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	counts := make(map[T]int, len(n))
+	maxCount := 0
+	for _, v := range n {
+		counts[v]++
+		maxCount = max(maxCount, counts[v])
+	}
+
+	for _, v := range n {
+		if counts[v] == maxCount {
+			return v, nil
+		}
+	}
+
+	return n[0], nil
+}
+
+// Percentile returns the p-th percentile of the values, p in the range [0, 100].
+// If the percentile falls between two values, it is linearly interpolated between them:
+// the rank of the percentile is p/100*(len(n)-1) in the sorted values. The original slice is not modified.
+// Returns ErrEmptySlice if the slice is empty and ErrInvalidPercentile if p is out of the range.
+func Percentile[T generics.Numeric](n []T, p float64) (float64, error) {
+	if len(n) == 0 {
+		return 0, ErrEmptySlice
+	}
+	if !(p >= 0 && p <= 100) {
+		return 0, ErrInvalidPercentile
+	}
+
+	sorted := make([]float64, len(n))
+	for i, v := range n {
+		sorted[i] = float64(v)
+	}
+	sort.Float64s(sorted)
+
+	rank := p / 100 * float64(len(sorted)-1)
+	lower := int(math.Floor(rank))
+	upper := int(math.Ceil(rank))
+	if lower == upper {
+		return sorted[lower], nil
+	}
+
+	return sorted[lower] + (sorted[upper]-sorted[lower])*(rank-float64(lower)), nil
+}
+
+// Variance returns the population variance of the values: the mean of squared deviations from the mean.
+// Returns ErrEmptySlice if the slice is empty.
+func Variance[T generics.Numeric](n []T) (float64, error) {
+	if len(n) == 0 {
+		return 0, ErrEmptySlice
+	}
+
+	_, variance := meanVariance(n)
+
+	return variance, nil
+}
+
+// StdDev returns the population standard deviation of the values, the square root of Variance.
+// Returns ErrEmptySlice if the slice is empty.
+func StdDev[T generics.Numeric](n []T) (float64, error) {
+	variance, err := Variance(n)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Sqrt(variance), nil
+}
+
+// MinMax returns the minimum and the maximum values in one pass.
+// Returns ErrEmptySlice if the slice is empty.
+func MinMax[T generics.Numeric](n []T) (T, T, error) {
+	if len(n) == 0 {
+		return 0, 0, ErrEmptySlice
+	}
+
+	minV, maxV := n[0], n[0]
+	for _, v := range n[1:] {
+		if v < minV {
+			minV = v
+		}
+		if v > maxV {
+			maxV = v
+		}
+	}
+
+	return minV, maxV, nil
+}
+
+// meanVariance returns the mean and the population variance of non-empty values using Welford's algorithm,
+// which is numerically stable and doesn't overflow on large sums.
+func meanVariance[T generics.Numeric](n []T) (float64, float64) {
+	var mean, m2 float64
+	for i, v := range n {
+		x := float64(v)
+		delta := x - mean
+		mean += delta / float64(i+1)
+		m2 += delta * (x - mean)
+	}
+
+	return mean, m2 / float64(len(n))
+}
@@ -0,0 +1,139 @@
+package slices
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStats_Empty(t *testing.T) {
+	_, err := Mean([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = Median[int](nil)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = Mode([]float64{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = Percentile([]int{}, 50)
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = Variance([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, err = StdDev([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+	_, _, err = MinMax([]int{})
+	assert.ErrorIs(t, err, ErrEmptySlice)
+}
+
+func TestMean(t *testing.T) {
+	mean, err := Mean([]int{1, 2, 3, 4})
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, mean)
+
+	mean, err = Mean([]float32{-1.5, 1.5, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 1.0, mean)
+
+	// Sum of integers overflows
+	mean, err = Mean([]int64{math.MaxInt64, math.MaxInt64, math.MaxInt64})
+	require.NoError(t, err)
+	assert.InDelta(t, float64(math.MaxInt64), mean, 1e3)
+
+	mean, err = Mean([]uint8{255, 255, 255})
+	require.NoError(t, err)
+	assert.Equal(t, 255.0, mean)
+}
+
+func TestMedian(t *testing.T) {
+	sl := []int{5, 1, 3}
+	median, err := Median(sl)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, median)
+	assert.Equal(t, []int{5, 1, 3}, sl)
+
+	median, err = Median([]int{4, 1, 3, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2.5, median)
+
+	median, err = Median([]uint{7})
+	require.NoError(t, err)
+	assert.Equal(t, 7.0, median)
+}
+
+func TestMode(t *testing.T) {
+	mode, err := Mode([]int{1, 2, 2, 3, 3, 3})
+	require.NoError(t, err)
+	assert.Equal(t, 3, mode)
+
+	// Ties are resolved by the first appearance
+	mode, err = Mode([]int{2, 1, 1, 2})
+	require.NoError(t, err)
+	assert.Equal(t, 2, mode)
+
+	fmode, err := Mode([]float64{0.5})
+	require.NoError(t, err)
+	assert.Equal(t, 0.5, fmode)
+}
+
+func TestPercentile(t *testing.T) {
+	sl := []int{15, 20, 35, 40, 50}
+
+	cases := []struct {
+		p        float64
+		expected float64
+	}{
+		{0, 15},
+		{25, 20},
+		{40, 29},
+		{50, 35},
+		{90, 46},
+		{100, 50},
+	}
+	for _, c := range cases {
+		v, err := Percentile(sl, c.p)
+		require.NoError(t, err)
+		assert.InDelta(t, c.expected, v, 1e-9, "p=%v", c.p)
+	}
+
+	for _, p := range []float64{-1, 100.1, math.NaN()} {
+		_, err := Percentile(sl, p)
+		assert.ErrorIs(t, err, ErrInvalidPercentile)
+	}
+
+	v, err := Percentile([]int{3}, 75)
+	require.NoError(t, err)
+	assert.Equal(t, 3.0, v)
+}
+
+func TestVariance(t *testing.T) {
+	sl := []int{2, 4, 4, 4, 5, 5, 7, 9}
+
+	variance, err := Variance(sl)
+	require.NoError(t, err)
+	assert.InDelta(t, 4.0, variance, 1e-9)
+
+	stdDev, err := StdDev(sl)
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, stdDev, 1e-9)
+
+	variance, err = Variance([]int{5})
+	require.NoError(t, err)
+	assert.Equal(t, 0.0, variance)
+
+	// Large values don't lose precision
+	variance, err = Variance([]int64{1e15 + 1, 1e15 + 3})
+	require.NoError(t, err)
+	assert.InDelta(t, 1.0, variance, 1e-9)
+}
+
+func TestMinMax(t *testing.T) {
+	minV, maxV, err := MinMax([]int{3, -1, 7, 0})
+	require.NoError(t, err)
+	assert.Equal(t, -1, minV)
+	assert.Equal(t, 7, maxV)
+
+	fMin, fMax, err := MinMax([]float64{1.5})
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, fMin)
+	assert.Equal(t, 1.5, fMax)
+}
@@ -0,0 +1,78 @@
+package slices
+
+import (
+	"cmp"
+
+	"github.com/nodasoft/go-utils/heap"
+)
+
+// rankedItem is an element of a slice with its index and key, used to select the best elements.
+type rankedItem[T any, K any] struct {
+	v   T
+	i   int
+	key K
+}
+
+// BottomK returns k smallest elements according to the comparator in ascending order,
+// taking O(n log k) time. Equal elements are taken and returned in the order of the slice.
+// If k is greater than the length of the slice, all elements are returned sorted. If k <= 0 or the slice is empty, returns nil.
+// The original slice is not modified.
+func BottomK[T any](sl []T, k int, c Comparator[T]) []T {
+	return selectK(sl, k, func(v T) T { return v }, c)
+}
+
+// TopK returns k largest elements according to the comparator in descending order, like BottomK does.
+// Equal elements are taken and returned in the order of the slice.
+func TopK[T any](sl []T, k int, c Comparator[T]) []T {
+	return BottomK(sl, k, c.Reverse())
+}
+
+// BottomKBy returns k elements with the smallest keys returned by keyFn in ascending order of keys, like BottomK does.
+// keyFn is called once per element.
+func BottomKBy[T any, K cmp.Ordered](sl []T, k int, keyFn func(T) K) []T {
+	return selectK(sl, k, keyFn, cmp.Compare[K])
+}
+
+// TopKBy returns k elements with the largest keys returned by keyFn in descending order of keys, like BottomK does.
+// keyFn is called once per element.
+func TopKBy[T any, K cmp.Ordered](sl []T, k int, keyFn func(T) K) []T {
+	return selectK(sl, k, keyFn, func(a, b K) int { return cmp.Compare(b, a) })
+}
+
+// selectK returns k elements with the smallest keys according to compare, ties are broken by indexes.
+func selectK[T, K any](sl []T, k int, keyFn func(T) K, compare func(a, b K) int) []T {
+	if k <= 0 || len(sl) == 0 {
+		return nil
+	}
+	k = min(k, len(sl))
+
+	less := func(a, b rankedItem[T, K]) int {
+		if r := compare(a.key, b.key); r != 0 {
+			return r
+		}
+
+		return cmp.Compare(a.i, b.i)
+	}
+
+	// max-heap of the best k elements, the worst of them is at the top
+	h := heap.New(func(a, b rankedItem[T, K]) int { return less(b, a) })
+	for i, v := range sl {
+		item := rankedItem[T, K]{v: v, i: i, key: keyFn(v)}
+		if h.Len() < k {
+			h.Push(item)
+			continue
+		}
+		if worst, _ := h.Peek(); less(item, worst) < 0 {
+			h.Pop()
+			h.Push(item)
+		}
+	}
+
+	res := make([]T, h.Len())
+	for i := len(res) - 1; i >= 0; i-- {
+		item, _ := h.Pop()
+		res[i] = item.v
+	}
+
+	return res
+}
@@ -0,0 +1,94 @@
+package slices
+
+import (
+	"cmp"
+	"math/rand"
+	stdslices "slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type topKOffer struct {
+	ID    int
+	Price int
+}
+
+func TestBottomKBy(t *testing.T) {
+	offers := []topKOffer{{1, 30}, {2, 10}, {3, 20}, {4, 10}, {5, 50}, {6, 20}}
+	price := func(o topKOffer) int { return o.Price }
+
+	// Ties are broken by the order of the slice
+	assert.Equal(t, []topKOffer{{2, 10}, {4, 10}, {3, 20}}, BottomKBy(offers, 3, price))
+	assert.Equal(t, []topKOffer{{2, 10}}, BottomKBy(offers, 1, price))
+	assert.Equal(t, []topKOffer{{5, 50}, {1, 30}, {3, 20}, {6, 20}}, TopKBy(offers, 4, price))
+
+	// k is greater than the length
+	assert.Equal(t, []topKOffer{{2, 10}, {4, 10}, {3, 20}, {6, 20}, {1, 30}, {5, 50}}, BottomKBy(offers, 10, price))
+
+	assert.Nil(t, BottomKBy(offers, 0, price))
+	assert.Nil(t, TopKBy(offers, -1, price))
+	assert.Nil(t, TopKBy([]topKOffer{}, 3, price))
+
+	// The original slice is not modified
+	assert.Equal(t, []topKOffer{{1, 30}, {2, 10}, {3, 20}, {4, 10}, {5, 50}, {6, 20}}, offers)
+
+	// keyFn is called once per element
+	calls := 0
+	BottomKBy(offers, 2, func(o topKOffer) int {
+		calls++
+		return o.Price
+	})
+	assert.Equal(t, len(offers), calls)
+}
+
+func TestBottomK(t *testing.T) {
+	assert.Equal(t, []int{1, 2, 2}, BottomK([]int{5, 2, 9, 1, 2, 7}, 3, cmp.Compare[int]))
+	assert.Equal(t, []int{9, 7}, TopK([]int{5, 2, 9, 1, 2, 7}, 2, cmp.Compare[int]))
+
+	offers := []topKOffer{{1, 30}, {2, 10}, {3, 30}, {4, 10}}
+	byPrice := By(func(o topKOffer) int { return o.Price }, Asc)
+	// Ties keep the order of the slice for both directions
+	assert.Equal(t, []topKOffer{{1, 30}, {3, 30}, {2, 10}}, TopK(offers, 3, byPrice))
+	assert.Equal(t, []topKOffer{{2, 10}, {4, 10}, {1, 30}}, BottomK(offers, 3, byPrice))
+}
+
+func TestBottomK_Random(t *testing.T) {
+	rnd := rand.New(rand.NewSource(1))
+	offers := make([]topKOffer, 1000)
+	for i := range offers {
+		offers[i] = topKOffer{ID: i, Price: rnd.Intn(100)}
+	}
+
+	sorted := append([]topKOffer(nil), offers...)
+	stdslices.SortStableFunc(sorted, func(a, b topKOffer) int { return cmp.Compare(a.Price, b.Price) })
+
+	for _, k := range []int{1, 10, 100, 1000} {
+		assert.Equal(t, sorted[:k], BottomKBy(offers, k, func(o topKOffer) int { return o.Price }))
+	}
+}
+
+func BenchmarkBottomK(b *testing.B) {
+	const size = 100_000
+
+	rnd := rand.New(rand.NewSource(1))
+	offers := make([]topKOffer, size)
+	for i := range offers {
+		offers[i] = topKOffer{ID: i, Price: rnd.Int()}
+	}
+	price := func(o topKOffer) int { return o.Price }
+
+	b.Run("BottomKBy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			BottomKBy(offers, 10, price)
+		}
+	})
+
+	b.Run("SortBy", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			sorted := append([]topKOffer(nil), offers...)
+			SortBy(sorted, price)
+			_ = sorted[:10]
+		}
+	})
+}